@@ -37,3 +37,53 @@ func DefaultRegistryApplyMonitoringCallback(stream apiv1.MonitoringPluginService
 		})
 	}
 }
+
+func checkpointState(state *apiv1.PluginState, data []byte) *apiv1.PluginState {
+	ret := &apiv1.PluginState{
+		Registry: data,
+	}
+
+	if state != nil {
+		ret.Other = state.Other
+	}
+
+	return ret
+}
+
+// DefaultRegistryCheckpointCallback returns registry checkpoint hook sending intermediate state to host.
+// State is used as a base for other (non-registry) plugin state.
+func DefaultRegistryCheckpointCallback(stream apiv1.DeployPluginService_ApplyServer, state *apiv1.PluginState) func([]byte) {
+	return func(data []byte) {
+		_ = stream.Send(&apiv1.ApplyResponse{
+			Response: &apiv1.ApplyResponse_Checkpoint{
+				Checkpoint: &apiv1.ApplyCheckpointResponse{
+					State: checkpointState(state, data),
+				},
+			},
+		})
+	}
+}
+
+func DefaultRegistryCheckpointDNSCallback(stream apiv1.DNSPluginService_ApplyDNSServer, state *apiv1.PluginState) func([]byte) {
+	return func(data []byte) {
+		_ = stream.Send(&apiv1.ApplyDNSResponse{
+			Response: &apiv1.ApplyDNSResponse_Checkpoint{
+				Checkpoint: &apiv1.ApplyCheckpointResponse{
+					State: checkpointState(state, data),
+				},
+			},
+		})
+	}
+}
+
+func DefaultRegistryCheckpointMonitoringCallback(stream apiv1.MonitoringPluginService_ApplyMonitoringServer, state *apiv1.PluginState) func([]byte) {
+	return func(data []byte) {
+		_ = stream.Send(&apiv1.ApplyMonitoringResponse{
+			Response: &apiv1.ApplyMonitoringResponse_Checkpoint{
+				Checkpoint: &apiv1.ApplyCheckpointResponse{
+					State: checkpointState(state, data),
+				},
+			},
+		})
+	}
+}
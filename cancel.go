@@ -0,0 +1,175 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	apiv1 "github.com/outblocks/outblocks-plugin-go/gen/api/v1"
+	"github.com/outblocks/outblocks-plugin-go/registry"
+)
+
+// applyCanceler tracks in-flight applies so that they can be cancelled gracefully.
+// Applies are cancelled with registry.ErrApplyCancelled cause so that registry drains in-flight changes.
+type applyCanceler struct {
+	mu      sync.Mutex
+	nextID  int
+	cancels map[int]context.CancelCauseFunc
+}
+
+func newApplyCanceler() *applyCanceler {
+	return &applyCanceler{
+		cancels: make(map[int]context.CancelCauseFunc),
+	}
+}
+
+func (c *applyCanceler) start(ctx context.Context) (context.Context, func()) {
+	ctx, cancel := context.WithCancelCause(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	id := c.nextID
+	c.nextID++
+	c.cancels[id] = cancel
+
+	return ctx, func() {
+		c.mu.Lock()
+		delete(c.cancels, id)
+		c.mu.Unlock()
+
+		cancel(nil)
+	}
+}
+
+func (c *applyCanceler) cancelAll() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, cancel := range c.cancels {
+		cancel(registry.ErrApplyCancelled)
+	}
+
+	return len(c.cancels) > 0
+}
+
+// cancelledByUser returns true if apply was cancelled with CancelApply or SIGINT and stream is still open.
+func cancelledByUser(ctx, streamCtx context.Context) bool {
+	return errors.Is(context.Cause(ctx), registry.ErrApplyCancelled) && streamCtx.Err() == nil
+}
+
+// cancelableApplyServer overrides stream context with cancelable one and tracks if apply sent done response.
+type cancelableApplyServer struct {
+	apiv1.DeployPluginService_ApplyServer
+
+	ctx  context.Context
+	done bool
+}
+
+func (s *cancelableApplyServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *cancelableApplyServer) Send(r *apiv1.ApplyResponse) error {
+	if r.GetDone() != nil {
+		s.done = true
+	}
+
+	return s.DeployPluginService_ApplyServer.Send(r)
+}
+
+type cancelableApplyDNSServer struct {
+	apiv1.DNSPluginService_ApplyDNSServer
+
+	ctx  context.Context
+	done bool
+}
+
+func (s *cancelableApplyDNSServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *cancelableApplyDNSServer) Send(r *apiv1.ApplyDNSResponse) error {
+	if r.GetDone() != nil {
+		s.done = true
+	}
+
+	return s.DNSPluginService_ApplyDNSServer.Send(r)
+}
+
+type cancelableApplyMonitoringServer struct {
+	apiv1.MonitoringPluginService_ApplyMonitoringServer
+
+	ctx  context.Context
+	done bool
+}
+
+func (s *cancelableApplyMonitoringServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *cancelableApplyMonitoringServer) Send(r *apiv1.ApplyMonitoringResponse) error {
+	if r.GetDone() != nil {
+		s.done = true
+	}
+
+	return s.MonitoringPluginService_ApplyMonitoringServer.Send(r)
+}
+
+// partialState returns state with registry dump after cancelled apply. If apply was cancelled before handler
+// loaded stored state into registry, stored state is returned unchanged so that host does not lose it.
+func partialState(state *apiv1.PluginState, reg *registry.Registry) (*apiv1.PluginState, error) {
+	if !reg.Loaded() && len(state.GetRegistry()) > 0 {
+		return state, nil
+	}
+
+	data, err := reg.Dump()
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.PluginState{
+		Registry: data,
+		Other:    state.GetOther(),
+	}, nil
+}
+
+// sendPartialApplyDone sends done response with state of registry after cancelled apply.
+func sendPartialApplyDone(r *apiv1.ApplyRequest, reg *registry.Registry, stream apiv1.DeployPluginService_ApplyServer) error {
+	state, err := partialState(r.State, reg)
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&apiv1.ApplyResponse{
+		Response: &apiv1.ApplyResponse_Done{
+			Done: &apiv1.ApplyDoneResponse{State: state},
+		},
+	})
+}
+
+func sendPartialApplyDNSDone(r *apiv1.ApplyDNSRequest, reg *registry.Registry, stream apiv1.DNSPluginService_ApplyDNSServer) error {
+	state, err := partialState(r.State, reg)
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&apiv1.ApplyDNSResponse{
+		Response: &apiv1.ApplyDNSResponse_Done{
+			Done: &apiv1.ApplyDNSDoneResponse{State: state},
+		},
+	})
+}
+
+func sendPartialApplyMonitoringDone(r *apiv1.ApplyMonitoringRequest, reg *registry.Registry, stream apiv1.MonitoringPluginService_ApplyMonitoringServer) error {
+	state, err := partialState(r.State, reg)
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&apiv1.ApplyMonitoringResponse{
+		Response: &apiv1.ApplyMonitoringResponse_Done{
+			Done: &apiv1.ApplyMonitoringDoneResponse{State: state},
+		},
+	})
+}
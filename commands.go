@@ -0,0 +1,61 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+
+	apiv1 "github.com/outblocks/outblocks-plugin-go/gen/api/v1"
+	"github.com/outblocks/outblocks-plugin-go/log"
+	"github.com/outblocks/outblocks-plugin-go/registry"
+)
+
+// GraphCommand is a name of command rendering registry dependency graph, e.g. `ok graph`.
+const GraphCommand = "graph"
+
+// RegistryGraphCommand handles GraphCommand for registry with plugin resources already registered.
+// Supported flags:
+//   - format: "dot" (default) or "json",
+//   - output: file to write graph to, by default it is printed using logger,
+//   - plan: if true, diff is calculated first so that nodes are annotated with planned changes.
+func RegistryGraphCommand(ctx context.Context, meta any, reg *registry.Registry, r *apiv1.CommandRequest, logger log.Logger) error {
+	err := reg.Load(r.PluginState.GetRegistry())
+	if err != nil {
+		return err
+	}
+
+	flags := r.Args.GetFlags().GetFields()
+
+	if flags["plan"].GetBoolValue() {
+		_, err = reg.ProcessAndDiff(ctx, meta)
+		if err != nil {
+			return err
+		}
+	}
+
+	g := reg.Graph()
+
+	var buf bytes.Buffer
+
+	switch format := flags["format"].GetStringValue(); format {
+	case "", "dot":
+		err = g.WriteDOT(&buf)
+	case "json":
+		err = g.WriteJSON(&buf)
+	default:
+		return fmt.Errorf("unknown graph format: %s", format)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if out := flags["output"].GetStringValue(); out != "" {
+		return os.WriteFile(out, buf.Bytes(), 0o644) //nolint:gosec
+	}
+
+	logger.Print(buf.String())
+
+	return nil
+}
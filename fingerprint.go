@@ -0,0 +1,40 @@
+package plugin
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	apiv1 "github.com/outblocks/outblocks-plugin-go/gen/api/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// PlanFingerprint returns fingerprint of plan inputs and registry state. It is used to verify that saved plan
+// is applied with exactly the same inputs and state that it was created from.
+func PlanFingerprint(r *apiv1.PlanRequest) string {
+	in := &apiv1.PlanRequest{
+		Apps:         r.Apps,
+		Dependencies: r.Dependencies,
+		Domains:      r.Domains,
+		Destroy:      r.Destroy,
+		State:        r.State,
+		Args:         r.Args,
+		Targets:      r.Targets,
+	}
+
+	data, _ := proto.MarshalOptions{Deterministic: true}.Marshal(in)
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
+
+func applyFingerprint(r *apiv1.ApplyRequest) string {
+	return PlanFingerprint(&apiv1.PlanRequest{
+		Apps:         r.Apps,
+		Dependencies: r.Dependencies,
+		Domains:      r.Domains,
+		Destroy:      r.Destroy,
+		State:        r.State,
+		Args:         r.Args,
+		Targets:      r.Targets,
+	})
+}
@@ -22,6 +22,7 @@ type HostServiceClient interface {
 	PromptInput(ctx context.Context, in *PromptInputRequest, opts ...grpc.CallOption) (*PromptInputResponse, error)
 	PromptSelect(ctx context.Context, in *PromptSelectRequest, opts ...grpc.CallOption) (*PromptSelectResponse, error)
 	Log(ctx context.Context, in *LogRequest, opts ...grpc.CallOption) (*LogResponse, error)
+	LogStream(ctx context.Context, opts ...grpc.CallOption) (HostService_LogStreamClient, error)
 	HostGetSecret(ctx context.Context, in *HostGetSecretRequest, opts ...grpc.CallOption) (*HostGetSecretResponse, error)
 }
 
@@ -69,6 +70,40 @@ func (c *hostServiceClient) Log(ctx context.Context, in *LogRequest, opts ...grp
 	return out, nil
 }
 
+func (c *hostServiceClient) LogStream(ctx context.Context, opts ...grpc.CallOption) (HostService_LogStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &HostService_ServiceDesc.Streams[0], "/api.v1.HostService/LogStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &hostServiceLogStreamClient{stream}
+	return x, nil
+}
+
+type HostService_LogStreamClient interface {
+	Send(*LogBatchRequest) error
+	CloseAndRecv() (*LogResponse, error)
+	grpc.ClientStream
+}
+
+type hostServiceLogStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *hostServiceLogStreamClient) Send(m *LogBatchRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *hostServiceLogStreamClient) CloseAndRecv() (*LogResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(LogResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *hostServiceClient) HostGetSecret(ctx context.Context, in *HostGetSecretRequest, opts ...grpc.CallOption) (*HostGetSecretResponse, error) {
 	out := new(HostGetSecretResponse)
 	err := c.cc.Invoke(ctx, "/api.v1.HostService/HostGetSecret", in, out, opts...)
@@ -86,6 +121,7 @@ type HostServiceServer interface {
 	PromptInput(context.Context, *PromptInputRequest) (*PromptInputResponse, error)
 	PromptSelect(context.Context, *PromptSelectRequest) (*PromptSelectResponse, error)
 	Log(context.Context, *LogRequest) (*LogResponse, error)
+	LogStream(HostService_LogStreamServer) error
 	HostGetSecret(context.Context, *HostGetSecretRequest) (*HostGetSecretResponse, error)
 }
 
@@ -105,6 +141,9 @@ func (UnimplementedHostServiceServer) PromptSelect(context.Context, *PromptSelec
 func (UnimplementedHostServiceServer) Log(context.Context, *LogRequest) (*LogResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Log not implemented")
 }
+func (UnimplementedHostServiceServer) LogStream(HostService_LogStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method LogStream not implemented")
+}
 func (UnimplementedHostServiceServer) HostGetSecret(context.Context, *HostGetSecretRequest) (*HostGetSecretResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HostGetSecret not implemented")
 }
@@ -192,6 +231,32 @@ func _HostService_Log_Handler(srv any, ctx context.Context, dec func(any) error,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HostService_LogStream_Handler(srv any, stream grpc.ServerStream) error {
+	return srv.(HostServiceServer).LogStream(&hostServiceLogStreamServer{stream})
+}
+
+type HostService_LogStreamServer interface {
+	SendAndClose(*LogResponse) error
+	Recv() (*LogBatchRequest, error)
+	grpc.ServerStream
+}
+
+type hostServiceLogStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *hostServiceLogStreamServer) SendAndClose(m *LogResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *hostServiceLogStreamServer) Recv() (*LogBatchRequest, error) {
+	m := new(LogBatchRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _HostService_HostGetSecret_Handler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
 	in := new(HostGetSecretRequest)
 	if err := dec(in); err != nil {
@@ -238,6 +303,12 @@ var HostService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _HostService_HostGetSecret_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "LogStream",
+			Handler:       _HostService_LogStream_Handler,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "api/v1/host.proto",
 }
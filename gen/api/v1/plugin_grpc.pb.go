@@ -508,6 +508,11 @@ var LockingPluginService_ServiceDesc = grpc.ServiceDesc{
 type DeployPluginServiceClient interface {
 	Plan(ctx context.Context, in *PlanRequest, opts ...grpc.CallOption) (*PlanResponse, error)
 	Apply(ctx context.Context, in *ApplyRequest, opts ...grpc.CallOption) (DeployPluginService_ApplyClient, error)
+	Import(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportResponse, error)
+	DetectDrift(ctx context.Context, in *DetectDriftRequest, opts ...grpc.CallOption) (*DetectDriftResponse, error)
+	// Stops scheduling new changes of in-flight applies, including DNS and monitoring ones, they finish
+	// with done response containing partial state.
+	CancelApply(ctx context.Context, in *CancelApplyRequest, opts ...grpc.CallOption) (*CancelApplyResponse, error)
 }
 
 type deployPluginServiceClient struct {
@@ -559,12 +564,44 @@ func (x *deployPluginServiceApplyClient) Recv() (*ApplyResponse, error) {
 	return m, nil
 }
 
+func (c *deployPluginServiceClient) Import(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportResponse, error) {
+	out := new(ImportResponse)
+	err := c.cc.Invoke(ctx, "/api.v1.DeployPluginService/Import", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deployPluginServiceClient) DetectDrift(ctx context.Context, in *DetectDriftRequest, opts ...grpc.CallOption) (*DetectDriftResponse, error) {
+	out := new(DetectDriftResponse)
+	err := c.cc.Invoke(ctx, "/api.v1.DeployPluginService/DetectDrift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deployPluginServiceClient) CancelApply(ctx context.Context, in *CancelApplyRequest, opts ...grpc.CallOption) (*CancelApplyResponse, error) {
+	out := new(CancelApplyResponse)
+	err := c.cc.Invoke(ctx, "/api.v1.DeployPluginService/CancelApply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DeployPluginServiceServer is the server API for DeployPluginService service.
 // All implementations should embed UnimplementedDeployPluginServiceServer
 // for forward compatibility
 type DeployPluginServiceServer interface {
 	Plan(context.Context, *PlanRequest) (*PlanResponse, error)
 	Apply(*ApplyRequest, DeployPluginService_ApplyServer) error
+	Import(context.Context, *ImportRequest) (*ImportResponse, error)
+	DetectDrift(context.Context, *DetectDriftRequest) (*DetectDriftResponse, error)
+	// Stops scheduling new changes of in-flight applies, including DNS and monitoring ones, they finish
+	// with done response containing partial state.
+	CancelApply(context.Context, *CancelApplyRequest) (*CancelApplyResponse, error)
 }
 
 // UnimplementedDeployPluginServiceServer should be embedded to have forward compatible implementations.
@@ -577,6 +614,15 @@ func (UnimplementedDeployPluginServiceServer) Plan(context.Context, *PlanRequest
 func (UnimplementedDeployPluginServiceServer) Apply(*ApplyRequest, DeployPluginService_ApplyServer) error {
 	return status.Errorf(codes.Unimplemented, "method Apply not implemented")
 }
+func (UnimplementedDeployPluginServiceServer) Import(context.Context, *ImportRequest) (*ImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
+func (UnimplementedDeployPluginServiceServer) DetectDrift(context.Context, *DetectDriftRequest) (*DetectDriftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetectDrift not implemented")
+}
+func (UnimplementedDeployPluginServiceServer) CancelApply(context.Context, *CancelApplyRequest) (*CancelApplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelApply not implemented")
+}
 
 // UnsafeDeployPluginServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to DeployPluginServiceServer will
@@ -628,6 +674,60 @@ func (x *deployPluginServiceApplyServer) Send(m *ApplyResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _DeployPluginService_Import_Handler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+	in := new(ImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeployPluginServiceServer).Import(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.DeployPluginService/Import",
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return srv.(DeployPluginServiceServer).Import(ctx, req.(*ImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeployPluginService_DetectDrift_Handler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+	in := new(DetectDriftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeployPluginServiceServer).DetectDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.DeployPluginService/DetectDrift",
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return srv.(DeployPluginServiceServer).DetectDrift(ctx, req.(*DetectDriftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeployPluginService_CancelApply_Handler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+	in := new(CancelApplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeployPluginServiceServer).CancelApply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.v1.DeployPluginService/CancelApply",
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return srv.(DeployPluginServiceServer).CancelApply(ctx, req.(*CancelApplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DeployPluginService_ServiceDesc is the grpc.ServiceDesc for DeployPluginService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -639,6 +739,18 @@ var DeployPluginService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Plan",
 			Handler:    _DeployPluginService_Plan_Handler,
 		},
+		{
+			MethodName: "Import",
+			Handler:    _DeployPluginService_Import_Handler,
+		},
+		{
+			MethodName: "DetectDrift",
+			Handler:    _DeployPluginService_DetectDrift_Handler,
+		},
+		{
+			MethodName: "CancelApply",
+			Handler:    _DeployPluginService_CancelApply_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1,6 +1,120 @@
 package plugin
 
+import (
+	"slices"
+	"sort"
+
+	apiv1 "github.com/outblocks/outblocks-plugin-go/gen/api/v1"
+)
+
+// EnvHostProtocol holds protocol version of host, older hosts don't set it and speak ProtocolV1.
+const EnvHostProtocol = "OUTBLOCKS_HOST_PROTOCOL"
+
+// Capabilities of optional methods, advertised next to names of services implemented by handler.
+const (
+	CapabilityDeployImport      = "api.v1.DeployPluginService/Import"
+	CapabilityDeployDetectDrift = "api.v1.DeployPluginService/DetectDrift"
+)
+
+// supportedProtocols lists protocol versions plugin can speak, oldest first.
+var supportedProtocols = []string{ProtocolV1, ProtocolV2}
+
 type Handshake struct {
-	Protocol string `json:"protocol"`
-	Addr     string `json:"addr"`
+	// Protocol is the version negotiated with host.
+	Protocol     string   `json:"protocol"`
+	Protocols    []string `json:"protocols,omitempty"`
+	Capabilities []string `json:"capabilities,omitempty"`
+	Addr         string   `json:"addr"`
+	Transport    string   `json:"transport,omitempty"`
+	// PEM encoded one-time certificate of plugin server, set if host requested TLS.
+	Cert string `json:"cert,omitempty"`
+}
+
+// negotiateProtocol returns host protocol version if plugin supports it, otherwise the newest one supported
+// by plugin so that host can decide if it can speak it based on advertised protocols.
+func negotiateProtocol(host string) string {
+	if host == "" {
+		return ProtocolV1
+	}
+
+	for _, p := range supportedProtocols {
+		if p == host {
+			return p
+		}
+	}
+
+	return supportedProtocols[len(supportedProtocols)-1]
+}
+
+// protocolAtLeast returns true if negotiated protocol is the same or newer than minimal one.
+func protocolAtLeast(protocol, minimal string) bool {
+	return slices.Index(supportedProtocols, protocol) >= slices.Index(supportedProtocols, minimal)
+}
+
+// capabilities returns sorted names of plugin services implemented by handler and of optional methods it supports.
+func capabilities(handler BasicPluginHandler) []string {
+	ret := []string{apiv1.BasicPluginService_ServiceDesc.ServiceName}
+
+	if h, ok := handler.(DeployPluginHandler); ok {
+		ret = append(ret, apiv1.DeployPluginService_ServiceDesc.ServiceName)
+
+		if _, ok := h.(DeployImportHandler); ok {
+			ret = append(ret, CapabilityDeployImport)
+		}
+
+		if _, ok := h.(DeployDriftHandler); ok {
+			ret = append(ret, CapabilityDeployDetectDrift)
+		}
+	}
+
+	if _, ok := handler.(DNSPluginHandler); ok {
+		ret = append(ret, apiv1.DNSPluginService_ServiceDesc.ServiceName)
+	}
+
+	if _, ok := handler.(MonitoringPluginHandler); ok {
+		ret = append(ret, apiv1.MonitoringPluginService_ServiceDesc.ServiceName)
+	}
+
+	if _, ok := handler.(LogsPluginHandler); ok {
+		ret = append(ret, apiv1.LogsPluginService_ServiceDesc.ServiceName)
+	}
+
+	if _, ok := handler.(CommandPluginHandler); ok {
+		ret = append(ret, apiv1.CommandPluginService_ServiceDesc.ServiceName)
+	}
+
+	if _, ok := handler.(RunPluginHandler); ok {
+		ret = append(ret, apiv1.RunPluginService_ServiceDesc.ServiceName)
+	}
+
+	if _, ok := handler.(StatePluginHandler); ok {
+		ret = append(ret, apiv1.StatePluginService_ServiceDesc.ServiceName)
+	}
+
+	if _, ok := handler.(LockingPluginHandler); ok {
+		ret = append(ret, apiv1.LockingPluginService_ServiceDesc.ServiceName)
+	}
+
+	if _, ok := handler.(DeployHookHandler); ok {
+		ret = append(ret, apiv1.DeployHookService_ServiceDesc.ServiceName)
+	}
+
+	if _, ok := handler.(SecretPluginHandler); ok {
+		ret = append(ret, apiv1.SecretPluginService_ServiceDesc.ServiceName)
+	}
+
+	sort.Strings(ret)
+
+	return ret
+}
+
+func (s *Server) handshake(t *transport, handler BasicPluginHandler) Handshake {
+	return Handshake{
+		Protocol:     s.protocol,
+		Protocols:    append([]string(nil), supportedProtocols...),
+		Capabilities: capabilities(handler),
+		Addr:         t.listener.Addr().String(),
+		Transport:    t.network(),
+		Cert:         string(t.certPEM),
+	}
 }
@@ -0,0 +1,132 @@
+package plugin
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/outblocks/outblocks-plugin-go/env"
+	apiv1 "github.com/outblocks/outblocks-plugin-go/gen/api/v1"
+	"github.com/outblocks/outblocks-plugin-go/log"
+	"github.com/outblocks/outblocks-plugin-go/registry"
+)
+
+type basicHandler struct{}
+
+func (basicHandler) Init(context.Context, env.Enver, log.Logger, apiv1.HostServiceClient) error {
+	return nil
+}
+
+func (basicHandler) Start(context.Context, *apiv1.StartRequest) (*apiv1.StartResponse, error) {
+	return &apiv1.StartResponse{}, nil
+}
+
+func (basicHandler) ProjectInit(context.Context, *apiv1.ProjectInitRequest) (*apiv1.ProjectInitResponse, error) {
+	return &apiv1.ProjectInitResponse{}, nil
+}
+
+type deployHandler struct {
+	basicHandler
+}
+
+func (deployHandler) Plan(context.Context, *registry.Registry, *apiv1.PlanRequest) (*apiv1.PlanResponse, error) {
+	return &apiv1.PlanResponse{}, nil
+}
+
+func (deployHandler) Apply(*apiv1.ApplyRequest, *registry.Registry, apiv1.DeployPluginService_ApplyServer) error {
+	return nil
+}
+
+type importDeployHandler struct {
+	deployHandler
+}
+
+func (importDeployHandler) Import(context.Context, *registry.Registry, *apiv1.ImportRequest) (*apiv1.ImportResponse, error) {
+	return &apiv1.ImportResponse{}, nil
+}
+
+type driftDeployHandler struct {
+	deployHandler
+}
+
+func (driftDeployHandler) DetectDrift(context.Context, *registry.Registry, *apiv1.DetectDriftRequest) (*apiv1.DetectDriftResponse, error) {
+	return &apiv1.DetectDriftResponse{}, nil
+}
+
+func TestNegotiateProtocol(t *testing.T) {
+	tests := []struct {
+		host string
+		want string
+	}{
+		{host: "", want: ProtocolV1},
+		{host: ProtocolV1, want: ProtocolV1},
+		{host: "v999", want: supportedProtocols[len(supportedProtocols)-1]},
+	}
+
+	for _, tc := range tests {
+		if got := negotiateProtocol(tc.host); got != tc.want {
+			t.Errorf("negotiateProtocol(%q): expected %q, got: %q", tc.host, tc.want, got)
+		}
+	}
+}
+
+func TestProtocolAtLeast(t *testing.T) {
+	if protocolAtLeast(ProtocolV1, ProtocolV2) {
+		t.Fatalf("expected %s to be older than %s", ProtocolV1, ProtocolV2)
+	}
+
+	if !protocolAtLeast(ProtocolV2, ProtocolV2) || !protocolAtLeast(ProtocolV2, ProtocolV1) {
+		t.Fatalf("expected %s to support %s features", ProtocolV2, ProtocolV1)
+	}
+}
+
+func TestCapabilities(t *testing.T) {
+	basic := apiv1.BasicPluginService_ServiceDesc.ServiceName
+	deploy := apiv1.DeployPluginService_ServiceDesc.ServiceName
+
+	tests := []struct {
+		name    string
+		handler BasicPluginHandler
+		want    []string
+	}{
+		{name: "basic", handler: basicHandler{}, want: []string{basic}},
+		{name: "deploy", handler: deployHandler{}, want: []string{basic, deploy}},
+		{name: "import", handler: importDeployHandler{}, want: []string{basic, deploy, CapabilityDeployImport}},
+		{name: "drift", handler: driftDeployHandler{}, want: []string{basic, deploy, CapabilityDeployDetectDrift}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			want := slices.Sorted(slices.Values(tc.want))
+
+			if got := capabilities(tc.handler); !slices.Equal(got, want) {
+				t.Fatalf("expected %v, got: %v", want, got)
+			}
+		})
+	}
+}
+
+func TestCapabilitiesSkipsDebugServices(t *testing.T) {
+	srv, closeSrv := NewGRPCServer(deployHandler{}, WithHealthService(), WithReflection())
+	defer closeSrv()
+
+	if len(srv.GetServiceInfo()) <= 2 {
+		t.Fatalf("expected debug services to be registered: %v", srv.GetServiceInfo())
+	}
+
+	caps := capabilities(deployHandler{})
+
+	for name := range srv.GetServiceInfo() {
+		if name == apiv1.DeployPluginService_ServiceDesc.ServiceName || name == apiv1.BasicPluginService_ServiceDesc.ServiceName {
+			if !slices.Contains(caps, name) {
+				t.Fatalf("expected registered service %s in capabilities: %v", name, caps)
+			}
+
+			continue
+		}
+
+		if slices.Contains(caps, name) {
+			t.Fatalf("unexpected service %s in capabilities: %v", name, caps)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package plugin
+
+import (
+	apiv1 "github.com/outblocks/outblocks-plugin-go/gen/api/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+// WithHealthService registers grpc.health.v1 service. Overall status is NOT_SERVING until both Init and Start
+// complete successfully, status of BasicPluginService becomes SERVING after Init.
+func WithHealthService() ServerOption {
+	return func(s *Server) {
+		s.health = health.NewServer()
+	}
+}
+
+// WithReflection registers server reflection service so that plugin can be inspected by tools like grpcurl.
+func WithReflection() ServerOption {
+	return func(s *Server) {
+		s.reflection = true
+	}
+}
+
+func (s *Server) registerDebugServices(grpcServer *grpc.Server) {
+	if s.health != nil {
+		s.health.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+		s.health.SetServingStatus(apiv1.BasicPluginService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_NOT_SERVING)
+
+		healthpb.RegisterHealthServer(grpcServer, s.health)
+	}
+
+	if s.reflection {
+		reflection.Register(grpcServer)
+	}
+}
+
+func setServingStatus(h *health.Server, service string) {
+	if h != nil {
+		h.SetServingStatus(service, healthpb.HealthCheckResponse_SERVING)
+	}
+}
@@ -0,0 +1,157 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/outblocks/outblocks-plugin-go/log"
+	"github.com/outblocks/outblocks-plugin-go/util/errgroup"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WithUnaryInterceptors adds unary interceptors, they are called after default recovery interceptor.
+func WithUnaryInterceptors(i ...grpc.UnaryServerInterceptor) ServerOption {
+	return func(s *Server) {
+		s.unaryInterceptors = append(s.unaryInterceptors, i...)
+	}
+}
+
+// WithStreamInterceptors adds stream interceptors, they are called after default recovery interceptor.
+func WithStreamInterceptors(i ...grpc.StreamServerInterceptor) ServerOption {
+	return func(s *Server) {
+		s.streamInterceptors = append(s.streamInterceptors, i...)
+	}
+}
+
+// WithRequestLogging logs every request through logger connected to host during Init.
+func WithRequestLogging() ServerOption {
+	return func(s *Server) {
+		s.requestLogging = true
+	}
+}
+
+// panicError converts recovered panic value to status error with stack trace in details.
+func panicError(method string, v any) error {
+	stack := debug.Stack()
+
+	var pe *errgroup.PanicError
+	if err, ok := v.(error); ok && errors.As(err, &pe) {
+		v = pe.Value
+		stack = pe.Stack
+	}
+
+	st := status.New(codes.Internal, fmt.Sprintf("panic in %s: %v", method, v))
+
+	ds, err := st.WithDetails(&errdetails.DebugInfo{
+		StackEntries: strings.Split(strings.TrimSpace(string(stack)), "\n"),
+		Detail:       fmt.Sprint(v),
+	})
+	if err != nil {
+		return st.Err()
+	}
+
+	return ds.Err()
+}
+
+// RecoveryUnaryInterceptor converts panics in handlers to codes.Internal errors instead of crashing plugin.
+func RecoveryUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if v := recover(); v != nil {
+				err = panicError(info.FullMethod, v)
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+// RecoveryStreamInterceptor converts panics in handlers to codes.Internal errors instead of crashing plugin.
+func RecoveryStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if v := recover(); v != nil {
+				err = panicError(info.FullMethod, v)
+			}
+		}()
+
+		return handler(srv, ss)
+	}
+}
+
+func logRequest(l log.Logger, method string, start time.Time, err error) {
+	if l == nil {
+		return
+	}
+
+	if err != nil {
+		l.Debugf("%s failed after %s: %s\n", method, time.Since(start), err)
+
+		return
+	}
+
+	l.Debugf("%s finished in %s\n", method, time.Since(start))
+}
+
+func loggingUnaryInterceptor(logger func() log.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		logRequest(logger(), info.FullMethod, start, err)
+
+		return resp, err
+	}
+}
+
+func loggingStreamInterceptor(logger func() log.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+
+		logRequest(logger(), info.FullMethod, start, err)
+
+		return err
+	}
+}
+
+// LoggingUnaryInterceptor logs method, duration and error of every unary request.
+func LoggingUnaryInterceptor(l log.Logger) grpc.UnaryServerInterceptor {
+	return loggingUnaryInterceptor(func() log.Logger { return l })
+}
+
+// LoggingStreamInterceptor logs method, duration and error of every stream request.
+func LoggingStreamInterceptor(l log.Logger) grpc.StreamServerInterceptor {
+	return loggingStreamInterceptor(func() log.Logger { return l })
+}
+
+func (s *Server) interceptors(basicWrapper *basicPluginHandlerWrapper) []grpc.ServerOption {
+	var (
+		unary  []grpc.UnaryServerInterceptor
+		stream []grpc.StreamServerInterceptor
+	)
+
+	// Logging wraps recovery so that recovered panics are logged as well.
+	if s.requestLogging {
+		unary = append(unary, loggingUnaryInterceptor(basicWrapper.logger))
+		stream = append(stream, loggingStreamInterceptor(basicWrapper.logger))
+	}
+
+	unary = append(unary, RecoveryUnaryInterceptor())
+	stream = append(stream, RecoveryStreamInterceptor())
+
+	unary = append(unary, s.unaryInterceptors...)
+	stream = append(stream, s.streamInterceptors...)
+
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	}
+}
@@ -0,0 +1,312 @@
+package log
+
+import (
+	"context"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	apiv1 "github.com/outblocks/outblocks-plugin-go/gen/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultBufferSize    = 1024
+	DefaultBatchSize     = 100
+	DefaultFlushInterval = 100 * time.Millisecond
+	DefaultMaxBlock      = time.Second
+)
+
+type BufferedOptions struct {
+	// BufferSize is number of lines queued before logging blocks.
+	BufferSize int
+	// BatchSize is max number of lines sent in one stream message.
+	BatchSize int
+	// FlushInterval limits how long lines wait in incomplete batch.
+	FlushInterval time.Duration
+	// MaxBlock limits how long logging blocks when buffer is full, line is written to fallback afterwards.
+	MaxBlock time.Duration
+	// Fallback receives lines that cannot be delivered to host, defaults to stderr.
+	Fallback io.Writer
+}
+
+func (o *BufferedOptions) withDefaults() BufferedOptions {
+	ret := BufferedOptions{}
+
+	if o != nil {
+		ret = *o
+	}
+
+	if ret.BufferSize <= 0 {
+		ret.BufferSize = DefaultBufferSize
+	}
+
+	if ret.BatchSize <= 0 {
+		ret.BatchSize = DefaultBatchSize
+	}
+
+	if ret.FlushInterval <= 0 {
+		ret.FlushInterval = DefaultFlushInterval
+	}
+
+	if ret.MaxBlock <= 0 {
+		ret.MaxBlock = DefaultMaxBlock
+	}
+
+	if ret.Fallback == nil {
+		ret.Fallback = os.Stderr
+	}
+
+	return ret
+}
+
+// BufferedLogger queues log lines and sends them in batches over LogStream in background.
+// Lines are written to fallback when host is not available, e.g. if client is nil or after Close.
+type BufferedLogger struct {
+	*Log
+
+	sender *bufferedSender
+}
+
+func NewBufferedLogger(cli apiv1.HostServiceClient, opts *BufferedOptions) *BufferedLogger {
+	o := opts.withDefaults()
+
+	s := &bufferedSender{
+		cli:      cli,
+		opts:     o,
+		fallback: newFallbackWriter(o.Fallback),
+		ch:       make(chan *apiv1.LogRequest, o.BufferSize),
+		flushCh:  make(chan chan struct{}),
+		done:     make(chan struct{}),
+	}
+
+	go s.run()
+
+	return &BufferedLogger{
+		Log:    &Log{sender: s},
+		sender: s,
+	}
+}
+
+// Flush waits until all queued lines are sent and received by host, e.g. before exiting.
+func (l *BufferedLogger) Flush() {
+	l.sender.flush()
+}
+
+// Close sends queued lines and closes stream. Lines logged afterwards are written to fallback.
+func (l *BufferedLogger) Close() {
+	l.sender.close()
+}
+
+type bufferedSender struct {
+	cli      apiv1.HostServiceClient
+	opts     BufferedOptions
+	fallback *fallbackWriter
+	stream   apiv1.HostService_LogStreamClient
+	// checked is set once host accepted lines sent over LogStream.
+	checked bool
+	// unary is set if host does not implement LogStream, lines are then sent one by one.
+	unary *unarySender
+
+	mu      sync.RWMutex
+	closed  bool
+	ch      chan *apiv1.LogRequest
+	flushCh chan chan struct{}
+	done    chan struct{}
+}
+
+func (s *bufferedSender) send(r *apiv1.LogRequest) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed {
+		s.fallback.write(r)
+
+		return
+	}
+
+	select {
+	case s.ch <- r:
+		return
+	default:
+	}
+
+	// Buffer is full, apply backpressure for a limited time.
+	t := time.NewTimer(s.opts.MaxBlock)
+	defer t.Stop()
+
+	select {
+	case s.ch <- r:
+	case <-t.C:
+		s.fallback.write(r)
+	}
+}
+
+func (s *bufferedSender) flush() {
+	ack := make(chan struct{})
+
+	select {
+	case s.flushCh <- ack:
+		<-ack
+	case <-s.done:
+	}
+}
+
+func (s *bufferedSender) close() {
+	s.mu.Lock()
+
+	if !s.closed {
+		s.closed = true
+		close(s.ch)
+	}
+
+	s.mu.Unlock()
+
+	<-s.done
+}
+
+func (s *bufferedSender) run() {
+	ticker := time.NewTicker(s.opts.FlushInterval)
+	defer ticker.Stop()
+
+	var batch []*apiv1.LogRequest
+
+	for {
+		select {
+		case r, ok := <-s.ch:
+			if !ok {
+				s.sendBatch(batch)
+				s.closeStream()
+				close(s.done)
+
+				return
+			}
+
+			batch = append(batch, r)
+
+			if len(batch) >= s.opts.BatchSize {
+				s.sendBatch(batch)
+				batch = nil
+			}
+
+		case <-ticker.C:
+			s.sendBatch(batch)
+			batch = nil
+
+		case ack := <-s.flushCh:
+			batch = s.drain(batch)
+			s.sendBatch(batch)
+			batch = nil
+
+			// Lines sent over stream are only known to be received once host responds to its closing,
+			// stream is reopened with next batch.
+			s.closeStream()
+
+			close(ack)
+		}
+	}
+}
+
+// drain appends lines already queued to batch.
+func (s *bufferedSender) drain(batch []*apiv1.LogRequest) []*apiv1.LogRequest {
+	for {
+		select {
+		case r, ok := <-s.ch:
+			if !ok {
+				return batch
+			}
+
+			batch = append(batch, r)
+		default:
+			return batch
+		}
+	}
+}
+
+func (s *bufferedSender) sendBatch(batch []*apiv1.LogRequest) {
+	for len(batch) > 0 {
+		n := min(len(batch), s.opts.BatchSize)
+
+		err := s.sendStream(batch[:n])
+		if err != nil {
+			s.fallback.write(batch[:n]...)
+		}
+
+		batch = batch[n:]
+	}
+}
+
+func (s *bufferedSender) sendStream(batch []*apiv1.LogRequest) error {
+	if s.cli == nil {
+		return io.ErrClosedPipe
+	}
+
+	if s.unary != nil {
+		s.sendUnary(batch)
+
+		return nil
+	}
+
+	if s.stream == nil {
+		stream, err := s.cli.LogStream(context.Background())
+		if err != nil {
+			return s.fallbackToUnary(err, batch)
+		}
+
+		s.stream = stream
+	}
+
+	err := s.stream.Send(&apiv1.LogBatchRequest{Logs: batch})
+	if err == nil && s.checked {
+		return nil
+	}
+
+	// Send returns io.EOF if host already ended stream, actual status is returned by CloseAndRecv.
+	// First stream is closed right after first batch to find out if host implements LogStream
+	// before more lines are sent over it. Stream is reopened with next batch.
+	_, closeErr := s.stream.CloseAndRecv()
+	s.stream = nil
+
+	if closeErr != nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return s.fallbackToUnary(err, batch)
+	}
+
+	s.checked = true
+
+	return nil
+}
+
+// fallbackToUnary switches to unary Log calls if host does not implement LogStream, e.g. older hosts.
+func (s *bufferedSender) fallbackToUnary(err error, batch []*apiv1.LogRequest) error {
+	if status.Code(err) != codes.Unimplemented {
+		return err
+	}
+
+	s.unary = &unarySender{
+		cli:      s.cli,
+		fallback: s.fallback,
+	}
+
+	s.sendUnary(batch)
+
+	return nil
+}
+
+func (s *bufferedSender) sendUnary(batch []*apiv1.LogRequest) {
+	for _, r := range batch {
+		s.unary.send(r)
+	}
+}
+
+func (s *bufferedSender) closeStream() {
+	if s.stream != nil {
+		_, _ = s.stream.CloseAndRecv()
+		s.stream = nil
+	}
+}
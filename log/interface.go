@@ -22,6 +22,10 @@ type Logger interface {
 	Print(a ...any)
 	Println(a ...any)
 	Printf(format string, a ...any)
+
+	// With returns logger that adds attributes to every log line. Arguments are key/value pairs or slog.Attr
+	// as in slog.Logger.With.
+	With(args ...any) Logger
 }
 
 var _ Logger = (*Log)(nil)
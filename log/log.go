@@ -1,7 +1,6 @@
 package log
 
 import (
-	"context"
 	"fmt"
 	"os"
 
@@ -9,22 +8,47 @@ import (
 )
 
 type Log struct {
-	cli apiv1.HostServiceClient
+	sender sender
+	attrs  []*apiv1.LogAttr
 }
 
+// sender delivers log lines to host.
+type sender interface {
+	send(r *apiv1.LogRequest)
+	flush()
+}
+
+// NewLogger creates logger sending every line to host with unary call, lines that cannot be delivered
+// are written to stderr.
 func NewLogger(cli apiv1.HostServiceClient) Logger {
 	return &Log{
-		cli: cli,
+		sender: &unarySender{
+			cli:      cli,
+			fallback: newFallbackWriter(os.Stderr),
+		},
 	}
 }
 
 func (l *Log) log(lvl apiv1.LogRequest_Level, msg string) {
-	_, _ = l.cli.Log(context.Background(), &apiv1.LogRequest{
+	l.sender.send(&apiv1.LogRequest{
 		Message: msg,
 		Level:   lvl,
+		Attrs:   l.attrs,
 	})
 }
 
+func (l *Log) With(args ...any) Logger {
+	return &Log{
+		sender: l.sender,
+		attrs:  appendAttrs(l.attrs, "", argsToAttrs(args)...),
+	}
+}
+
+func (l *Log) exit() {
+	l.sender.flush()
+	os.Exit(1)
+}
+
 func (l *Log) writeln(lvl apiv1.LogRequest_Level, a ...any) {
 	l.log(lvl, fmt.Sprintln(a...))
 }
@@ -39,17 +63,17 @@ func (l *Log) write(lvl apiv1.LogRequest_Level, a ...any) {
 
 func (l *Log) Fatalln(a ...any) {
 	l.writeln(apiv1.LogRequest_LEVEL_ERROR, a...)
-	os.Exit(1)
+	l.exit()
 }
 
 func (l *Log) Fatalf(format string, a ...any) {
 	l.writef(apiv1.LogRequest_LEVEL_ERROR, format, a...)
-	os.Exit(1)
+	l.exit()
 }
 
 func (l *Log) Fatal(a ...any) {
 	l.write(apiv1.LogRequest_LEVEL_ERROR, a...)
-	os.Exit(1)
+	l.exit()
 }
 
 func (l *Log) Errorln(a ...any) {
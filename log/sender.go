@@ -0,0 +1,76 @@
+package log
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	apiv1 "github.com/outblocks/outblocks-plugin-go/gen/api/v1"
+)
+
+const FallbackFileName = "plugin.log"
+
+// OpenFallbackFile opens log file in dir, e.g. plugin project cache dir, to be used as fallback of buffered logger.
+func OpenFallbackFile(dir string) (*os.File, error) {
+	err := os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return nil, err
+	}
+
+	return os.OpenFile(filepath.Join(dir, FallbackFileName), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+}
+
+// fallbackWriter writes log lines that cannot be delivered to host.
+type fallbackWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func newFallbackWriter(w io.Writer) *fallbackWriter {
+	return &fallbackWriter{w: w}
+}
+
+func (f *fallbackWriter) write(reqs ...*apiv1.LogRequest) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, r := range reqs {
+		var sb strings.Builder
+
+		sb.WriteString(strings.TrimPrefix(r.Level.String(), "LEVEL_"))
+		sb.WriteByte(' ')
+		sb.WriteString(strings.TrimRight(r.Message, "\n"))
+
+		for _, a := range r.Attrs {
+			fmt.Fprintf(&sb, " %s=%v", a.Key, a.Value.AsInterface())
+		}
+
+		sb.WriteByte('\n')
+
+		_, _ = io.WriteString(f.w, sb.String())
+	}
+}
+
+type unarySender struct {
+	cli      apiv1.HostServiceClient
+	fallback *fallbackWriter
+}
+
+func (s *unarySender) send(r *apiv1.LogRequest) {
+	if s.cli == nil {
+		s.fallback.write(r)
+
+		return
+	}
+
+	_, err := s.cli.Log(context.Background(), r)
+	if err != nil {
+		s.fallback.write(r)
+	}
+}
+
+func (s *unarySender) flush() {}
@@ -0,0 +1,190 @@
+package log
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	apiv1 "github.com/outblocks/outblocks-plugin-go/gen/api/v1"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// SlogHandler is slog.Handler sending records to host.
+type SlogHandler struct {
+	sender sender
+	level  slog.Leveler
+	attrs  []*apiv1.LogAttr
+	group  string
+}
+
+var _ slog.Handler = (*SlogHandler)(nil)
+
+// NewSlogHandler creates slog handler sending every record to host with unary call. Records below level are dropped,
+// nil level defaults to slog.LevelInfo.
+func NewSlogHandler(cli apiv1.HostServiceClient, level slog.Leveler) *SlogHandler {
+	return newSlogHandler(&unarySender{
+		cli:      cli,
+		fallback: newFallbackWriter(os.Stderr),
+	}, nil, level)
+}
+
+// SlogHandler creates slog handler sending records the same way as logger, e.g. in batches for BufferedLogger,
+// with logger attributes added to every record.
+func (l *Log) SlogHandler(level slog.Leveler) *SlogHandler {
+	return newSlogHandler(l.sender, l.attrs, level)
+}
+
+func newSlogHandler(s sender, attrs []*apiv1.LogAttr, level slog.Leveler) *SlogHandler {
+	if level == nil {
+		level = slog.LevelInfo
+	}
+
+	return &SlogHandler{
+		sender: s,
+		level:  level,
+		attrs:  attrs,
+	}
+}
+
+func (h *SlogHandler) Enabled(_ context.Context, lvl slog.Level) bool {
+	return lvl >= h.level.Level()
+}
+
+// Handle sends record to host, records that cannot be delivered are written to fallback of sender.
+func (h *SlogHandler) Handle(_ context.Context, r slog.Record) error { //nolint:gocritic
+	attrs := make([]slog.Attr, 0, r.NumAttrs())
+
+	r.Attrs(func(a slog.Attr) bool {
+		attrs = append(attrs, a)
+
+		return true
+	})
+
+	h.sender.send(&apiv1.LogRequest{
+		Message: r.Message,
+		Level:   levelFromSlog(r.Level),
+		Attrs:   appendAttrs(h.attrs, h.group, attrs...),
+	})
+
+	return nil
+}
+
+func (h *SlogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	ret := *h
+	ret.attrs = appendAttrs(h.attrs, h.group, attrs...)
+
+	return &ret
+}
+
+func (h *SlogHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	ret := *h
+	ret.group = groupKey(h.group, name)
+
+	return &ret
+}
+
+func levelFromSlog(lvl slog.Level) apiv1.LogRequest_Level {
+	switch {
+	case lvl >= slog.LevelError:
+		return apiv1.LogRequest_LEVEL_ERROR
+	case lvl >= slog.LevelWarn:
+		return apiv1.LogRequest_LEVEL_WARN
+	case lvl >= slog.LevelInfo:
+		return apiv1.LogRequest_LEVEL_INFO
+	default:
+		return apiv1.LogRequest_LEVEL_DEBUG
+	}
+}
+
+func groupKey(group, key string) string {
+	if group == "" {
+		return key
+	}
+
+	return group + "." + key
+}
+
+// argsToAttrs parses key/value pairs the same way as slog.Logger.With.
+func argsToAttrs(args []any) []slog.Attr {
+	var r slog.Record
+
+	r.Add(args...)
+
+	ret := make([]slog.Attr, 0, r.NumAttrs())
+
+	r.Attrs(func(a slog.Attr) bool {
+		ret = append(ret, a)
+
+		return true
+	})
+
+	return ret
+}
+
+// appendAttrs converts attributes to proto ones, group attributes are flattened with dotted keys.
+func appendAttrs(dst []*apiv1.LogAttr, group string, attrs ...slog.Attr) []*apiv1.LogAttr {
+	ret := make([]*apiv1.LogAttr, len(dst), len(dst)+len(attrs))
+	copy(ret, dst)
+
+	for _, a := range attrs {
+		v := a.Value.Resolve()
+
+		if a.Equal(slog.Attr{}) {
+			continue
+		}
+
+		if v.Kind() == slog.KindGroup {
+			key := group
+			if a.Key != "" {
+				key = groupKey(group, a.Key)
+			}
+
+			ret = appendAttrs(ret, key, v.Group()...)
+
+			continue
+		}
+
+		ret = append(ret, &apiv1.LogAttr{
+			Key:   groupKey(group, a.Key),
+			Value: protoValue(v),
+		})
+	}
+
+	return ret
+}
+
+func protoValue(v slog.Value) *structpb.Value {
+	switch v.Kind() {
+	case slog.KindString:
+		return structpb.NewStringValue(v.String())
+	case slog.KindInt64:
+		return structpb.NewNumberValue(float64(v.Int64()))
+	case slog.KindUint64:
+		return structpb.NewNumberValue(float64(v.Uint64()))
+	case slog.KindFloat64:
+		return structpb.NewNumberValue(v.Float64())
+	case slog.KindBool:
+		return structpb.NewBoolValue(v.Bool())
+	case slog.KindDuration:
+		return structpb.NewStringValue(v.Duration().String())
+	case slog.KindTime:
+		return structpb.NewStringValue(v.Time().Format(time.RFC3339Nano))
+	case slog.KindAny, slog.KindGroup, slog.KindLogValuer:
+	}
+
+	if err, ok := v.Any().(error); ok {
+		return structpb.NewStringValue(err.Error())
+	}
+
+	if pv, err := structpb.NewValue(v.Any()); err == nil {
+		return pv
+	}
+
+	return structpb.NewStringValue(fmt.Sprint(v.Any()))
+}
@@ -0,0 +1,181 @@
+package plugintest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	apiv1 "github.com/outblocks/outblocks-plugin-go/gen/api/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Host is a fake host service client that records log lines, serves scripted prompt answers and secrets.
+// Prompts without scripted answer return request default.
+type Host struct {
+	mu sync.Mutex
+
+	logs          []*apiv1.LogRequest
+	confirmations []bool
+	inputs        []string
+	selects       []string
+	secrets       map[string]string
+}
+
+func NewHost() *Host {
+	return &Host{
+		secrets: make(map[string]string),
+	}
+}
+
+func (h *Host) AnswerConfirmation(answers ...bool) *Host {
+	h.mu.Lock()
+	h.confirmations = append(h.confirmations, answers...)
+	h.mu.Unlock()
+
+	return h
+}
+
+func (h *Host) AnswerInput(answers ...string) *Host {
+	h.mu.Lock()
+	h.inputs = append(h.inputs, answers...)
+	h.mu.Unlock()
+
+	return h
+}
+
+func (h *Host) AnswerSelect(answers ...string) *Host {
+	h.mu.Lock()
+	h.selects = append(h.selects, answers...)
+	h.mu.Unlock()
+
+	return h
+}
+
+func (h *Host) SetSecret(key, value string) *Host {
+	h.mu.Lock()
+	h.secrets[key] = value
+	h.mu.Unlock()
+
+	return h
+}
+
+func (h *Host) Logs() []*apiv1.LogRequest {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	ret := make([]*apiv1.LogRequest, len(h.logs))
+	copy(ret, h.logs)
+
+	return ret
+}
+
+func (h *Host) LogMessages(lvl apiv1.LogRequest_Level) []string {
+	var ret []string
+
+	for _, l := range h.Logs() {
+		if lvl == apiv1.LogRequest_LEVEL_UNSPECIFIED || l.Level == lvl {
+			ret = append(ret, l.Message)
+		}
+	}
+
+	return ret
+}
+
+func (h *Host) PromptConfirmation(ctx context.Context, in *apiv1.PromptConfirmationRequest, opts ...grpc.CallOption) (*apiv1.PromptConfirmationResponse, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	ret := in.Default
+
+	if len(h.confirmations) > 0 {
+		ret = h.confirmations[0]
+		h.confirmations = h.confirmations[1:]
+	}
+
+	return &apiv1.PromptConfirmationResponse{Confirmed: ret}, nil
+}
+
+func (h *Host) PromptInput(ctx context.Context, in *apiv1.PromptInputRequest, opts ...grpc.CallOption) (*apiv1.PromptInputResponse, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	ret := in.Default
+
+	if len(h.inputs) > 0 {
+		ret = h.inputs[0]
+		h.inputs = h.inputs[1:]
+	}
+
+	return &apiv1.PromptInputResponse{Answer: ret}, nil
+}
+
+func (h *Host) PromptSelect(ctx context.Context, in *apiv1.PromptSelectRequest, opts ...grpc.CallOption) (*apiv1.PromptSelectResponse, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	ret := in.Default
+
+	if len(h.selects) > 0 {
+		ret = h.selects[0]
+		h.selects = h.selects[1:]
+	}
+
+	return &apiv1.PromptSelectResponse{Answer: ret}, nil
+}
+
+func (h *Host) Log(ctx context.Context, in *apiv1.LogRequest, opts ...grpc.CallOption) (*apiv1.LogResponse, error) {
+	h.mu.Lock()
+	h.logs = append(h.logs, in)
+	h.mu.Unlock()
+
+	return &apiv1.LogResponse{}, nil
+}
+
+func (h *Host) LogStream(ctx context.Context, opts ...grpc.CallOption) (apiv1.HostService_LogStreamClient, error) {
+	return &hostLogStream{host: h, ctx: ctx}, nil
+}
+
+// hostLogStream records batches sent over LogStream as they arrive.
+type hostLogStream struct {
+	host *Host
+	ctx  context.Context
+}
+
+func (s *hostLogStream) Send(r *apiv1.LogBatchRequest) error {
+	s.host.mu.Lock()
+	s.host.logs = append(s.host.logs, r.Logs...)
+	s.host.mu.Unlock()
+
+	return nil
+}
+
+func (s *hostLogStream) CloseAndRecv() (*apiv1.LogResponse, error) {
+	return &apiv1.LogResponse{}, nil
+}
+
+func (s *hostLogStream) Header() (metadata.MD, error) { return nil, nil }
+func (s *hostLogStream) Trailer() metadata.MD         { return nil }
+func (s *hostLogStream) CloseSend() error             { return nil }
+func (s *hostLogStream) Context() context.Context     { return s.ctx }
+func (s *hostLogStream) RecvMsg(m any) error          { return nil }
+
+func (s *hostLogStream) SendMsg(m any) error {
+	r, ok := m.(*apiv1.LogBatchRequest)
+	if !ok {
+		return fmt.Errorf("unexpected message type: %T", m)
+	}
+
+	return s.Send(r)
+}
+
+func (h *Host) HostGetSecret(ctx context.Context, in *apiv1.HostGetSecretRequest, opts ...grpc.CallOption) (*apiv1.HostGetSecretResponse, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	v, ok := h.secrets[in.Key]
+
+	return &apiv1.HostGetSecretResponse{Value: v, Specified: ok}, nil
+}
+
+var _ apiv1.HostServiceClient = (*Host)(nil)
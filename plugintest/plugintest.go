@@ -0,0 +1,226 @@
+// Package plugintest runs plugin handlers in-process for testing purposes.
+package plugintest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+
+	plugin "github.com/outblocks/outblocks-plugin-go"
+	apiv1 "github.com/outblocks/outblocks-plugin-go/gen/api/v1"
+	"github.com/outblocks/outblocks-plugin-go/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const bufSize = 1024 * 1024
+
+type options struct {
+	host       *Host
+	serverOpts []plugin.ServerOption
+}
+
+type Option func(*options)
+
+func WithHost(h *Host) Option {
+	return func(o *options) {
+		o.host = h
+	}
+}
+
+func WithServerOptions(opts ...plugin.ServerOption) Option {
+	return func(o *options) {
+		o.serverOpts = append(o.serverOpts, opts...)
+	}
+}
+
+// Harness serves plugin handler over in-memory listener and exposes clients for all plugin services.
+type Harness struct {
+	Host *Host
+
+	Basic      apiv1.BasicPluginServiceClient
+	Deploy     apiv1.DeployPluginServiceClient
+	DNS        apiv1.DNSPluginServiceClient
+	Monitoring apiv1.MonitoringPluginServiceClient
+	Logs       apiv1.LogsPluginServiceClient
+	Command    apiv1.CommandPluginServiceClient
+	Run        apiv1.RunPluginServiceClient
+	State      apiv1.StatePluginServiceClient
+	Locking    apiv1.LockingPluginServiceClient
+	DeployHook apiv1.DeployHookServiceClient
+	Secret     apiv1.SecretPluginServiceClient
+	Health     healthpb.HealthClient
+
+	server      *grpc.Server
+	closeServer func()
+	conn        *grpc.ClientConn
+}
+
+func New(handler plugin.BasicPluginHandler, opts ...Option) (*Harness, error) {
+	o := &options{}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if o.host == nil {
+		o.host = NewHost()
+	}
+
+	lis := bufconn.Listen(bufSize)
+	// Harness host speaks the newest protocol unless overridden with plugin.WithHostProtocol.
+	srv, closeSrv := plugin.NewGRPCServer(handler, append([]plugin.ServerOption{
+		plugin.WithHostClient(o.host),
+		plugin.WithHostProtocol(plugin.ProtocolV2),
+	}, o.serverOpts...)...)
+
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		srv.Stop()
+		closeSrv()
+
+		return nil, err
+	}
+
+	return &Harness{
+		Host: o.host,
+
+		Basic:      apiv1.NewBasicPluginServiceClient(conn),
+		Deploy:     apiv1.NewDeployPluginServiceClient(conn),
+		DNS:        apiv1.NewDNSPluginServiceClient(conn),
+		Monitoring: apiv1.NewMonitoringPluginServiceClient(conn),
+		Logs:       apiv1.NewLogsPluginServiceClient(conn),
+		Command:    apiv1.NewCommandPluginServiceClient(conn),
+		Run:        apiv1.NewRunPluginServiceClient(conn),
+		State:      apiv1.NewStatePluginServiceClient(conn),
+		Locking:    apiv1.NewLockingPluginServiceClient(conn),
+		DeployHook: apiv1.NewDeployHookServiceClient(conn),
+		Secret:     apiv1.NewSecretPluginServiceClient(conn),
+		Health:     healthpb.NewHealthClient(conn),
+
+		server:      srv,
+		closeServer: closeSrv,
+		conn:        conn,
+	}, nil
+}
+
+// Close stops server and flushes logger passed to plugin Init.
+func (h *Harness) Close() error {
+	err := h.conn.Close()
+
+	h.server.Stop()
+	h.closeServer()
+
+	return err
+}
+
+func (h *Harness) Init(ctx context.Context) error {
+	_, err := h.Basic.Init(ctx, &apiv1.InitRequest{})
+
+	return err
+}
+
+func (h *Harness) Start(ctx context.Context, props map[string]any) error {
+	_, err := h.Basic.Start(ctx, &apiv1.StartRequest{
+		Properties: util.MustNewStruct(props),
+	})
+
+	return err
+}
+
+func (h *Harness) Plan(ctx context.Context, req *apiv1.PlanRequest) (*apiv1.PlanResponse, error) {
+	return h.Deploy.Plan(ctx, req)
+}
+
+func (h *Harness) PlanDNS(ctx context.Context, req *apiv1.PlanDNSRequest) (*apiv1.PlanDNSResponse, error) {
+	return h.DNS.PlanDNS(ctx, req)
+}
+
+func (h *Harness) PlanMonitoring(ctx context.Context, req *apiv1.PlanMonitoringRequest) (*apiv1.PlanMonitoringResponse, error) {
+	return h.Monitoring.PlanMonitoring(ctx, req)
+}
+
+// ApplyResult holds all streamed apply actions and checkpoints in order of arrival and final done response.
+type ApplyResult[D any] struct {
+	Actions     []*apiv1.ApplyAction
+	Checkpoints []*apiv1.PluginState
+	Done        D
+}
+
+type applyResponse[D any] interface {
+	GetAction() *apiv1.ApplyActionResponse
+	GetCheckpoint() *apiv1.ApplyCheckpointResponse
+	GetDone() D
+}
+
+type applyStream[R any] interface {
+	Recv() (R, error)
+}
+
+func collectApply[R applyResponse[D], D any](stream applyStream[R]) (*ApplyResult[D], error) {
+	ret := &ApplyResult[D]{}
+
+	for {
+		res, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return ret, nil
+		}
+
+		if err != nil {
+			return ret, err
+		}
+
+		if a := res.GetAction(); a != nil {
+			ret.Actions = append(ret.Actions, a.Actions...)
+
+			continue
+		}
+
+		if c := res.GetCheckpoint(); c != nil {
+			ret.Checkpoints = append(ret.Checkpoints, c.State)
+
+			continue
+		}
+
+		ret.Done = res.GetDone()
+	}
+}
+
+func (h *Harness) Apply(ctx context.Context, req *apiv1.ApplyRequest) (*ApplyResult[*apiv1.ApplyDoneResponse], error) {
+	stream, err := h.Deploy.Apply(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectApply[*apiv1.ApplyResponse](stream)
+}
+
+func (h *Harness) ApplyDNS(ctx context.Context, req *apiv1.ApplyDNSRequest) (*ApplyResult[*apiv1.ApplyDNSDoneResponse], error) {
+	stream, err := h.DNS.ApplyDNS(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectApply[*apiv1.ApplyDNSResponse](stream)
+}
+
+func (h *Harness) ApplyMonitoring(ctx context.Context, req *apiv1.ApplyMonitoringRequest) (*ApplyResult[*apiv1.ApplyMonitoringDoneResponse], error) {
+	stream, err := h.Monitoring.ApplyMonitoring(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectApply[*apiv1.ApplyMonitoringResponse](stream)
+}
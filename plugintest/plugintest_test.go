@@ -0,0 +1,819 @@
+package plugintest_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	plugin "github.com/outblocks/outblocks-plugin-go"
+
+	"github.com/outblocks/outblocks-plugin-go/env"
+	apiv1 "github.com/outblocks/outblocks-plugin-go/gen/api/v1"
+	"github.com/outblocks/outblocks-plugin-go/log"
+	"github.com/outblocks/outblocks-plugin-go/plugintest"
+	"github.com/outblocks/outblocks-plugin-go/registry"
+	"github.com/outblocks/outblocks-plugin-go/registry/fields"
+	"github.com/outblocks/outblocks-plugin-go/resources"
+	"github.com/outblocks/outblocks-plugin-go/types"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+type testPlugin struct {
+	log  log.Logger
+	host apiv1.HostServiceClient
+
+	resources func(reg *registry.Registry) error
+}
+
+func (p *testPlugin) Init(ctx context.Context, e env.Enver, l log.Logger, cli apiv1.HostServiceClient) error {
+	p.log = l
+	p.host = cli
+
+	return nil
+}
+
+func (p *testPlugin) Start(ctx context.Context, r *apiv1.StartRequest) (*apiv1.StartResponse, error) {
+	p.log.Infoln("started")
+
+	return &apiv1.StartResponse{}, nil
+}
+
+func (p *testPlugin) ProjectInit(ctx context.Context, r *apiv1.ProjectInitRequest) (*apiv1.ProjectInitResponse, error) {
+	return &apiv1.ProjectInitResponse{}, nil
+}
+
+func (p *testPlugin) register(reg *registry.Registry, state *apiv1.PluginState) error {
+	var err error
+
+	if p.resources != nil {
+		err = p.resources(reg)
+	} else {
+		_, err = reg.RegisterPluginResource("test", "secret", &resources.RandomString{
+			Name: fields.String("secret"),
+		})
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if state != nil {
+		return reg.Load(state.Registry)
+	}
+
+	return nil
+}
+
+func (p *testPlugin) Plan(ctx context.Context, reg *registry.Registry, r *apiv1.PlanRequest) (*apiv1.PlanResponse, error) {
+	err := p.register(reg, r.State)
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := reg.ProcessAndDiff(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.PlanResponse{
+		Plan: &apiv1.Plan{
+			Actions: registry.PlanActionFromDiff(diff),
+		},
+	}, nil
+}
+
+func (p *testPlugin) Apply(r *apiv1.ApplyRequest, reg *registry.Registry, stream apiv1.DeployPluginService_ApplyServer) error {
+	ctx := stream.Context()
+
+	err := p.register(reg, r.State)
+	if err != nil {
+		return err
+	}
+
+	diff, err := reg.ProcessAndDiff(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	err = reg.Apply(ctx, nil, diff, func(a *apiv1.ApplyAction) {
+		_ = stream.Send(&apiv1.ApplyResponse{
+			Response: &apiv1.ApplyResponse_Action{
+				Action: &apiv1.ApplyActionResponse{Actions: []*apiv1.ApplyAction{a}},
+			},
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := reg.Dump()
+	if err != nil {
+		return err
+	}
+
+	state := types.NewPluginState()
+	state.Registry = data
+
+	return stream.Send(&apiv1.ApplyResponse{
+		Response: &apiv1.ApplyResponse_Done{
+			Done: &apiv1.ApplyDoneResponse{State: state},
+		},
+	})
+}
+
+func TestHarness(t *testing.T) {
+	ctx := context.Background()
+
+	h, err := plugintest.New(&testPlugin{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer h.Close()
+
+	if err := h.Init(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := h.Start(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if msgs := h.Host.LogMessages(apiv1.LogRequest_LEVEL_INFO); len(msgs) != 1 || msgs[0] != "started\n" {
+		t.Fatalf("unexpected logs: %q", msgs)
+	}
+
+	plan, err := h.Plan(ctx, &apiv1.PlanRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(plan.Plan.Actions) != 1 || plan.Plan.Actions[0].Type != apiv1.PlanType_PLAN_TYPE_CREATE {
+		t.Fatalf("expected single create action, got: %v", plan.Plan.Actions)
+	}
+
+	var length *apiv1.PlanFieldChange
+
+	for _, fc := range plan.Plan.Actions[0].FieldChanges {
+		if fc.Name == "Length" {
+			length = fc
+		}
+	}
+
+	if length == nil || length.OldValue != nil || length.NewValue.GetNumberValue() != 16 || !length.ForceNew {
+		t.Fatalf("unexpected Length field change: %v", length)
+	}
+
+	res, err := h.Apply(ctx, &apiv1.ApplyRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res.Actions) != 2 || res.Actions[1].Progress != 1 {
+		t.Fatalf("unexpected apply actions: %v", res.Actions)
+	}
+
+	if res.Done == nil || len(res.Done.State.Registry) == 0 {
+		t.Fatal("expected state in done response")
+	}
+
+	if len(res.Checkpoints) == 0 || len(res.Checkpoints[len(res.Checkpoints)-1].Registry) == 0 {
+		t.Fatal("expected checkpoint with registry state")
+	}
+
+	// Applying again with saved state should be a no-op.
+	plan, err = h.Plan(ctx, &apiv1.PlanRequest{State: res.Done.State})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(plan.Plan.Actions) != 0 {
+		t.Fatalf("expected no actions, got: %v", plan.Plan.Actions)
+	}
+}
+
+func TestNoCheckpointsForOldHost(t *testing.T) {
+	ctx := context.Background()
+
+	h, err := plugintest.New(&testPlugin{}, plugintest.WithServerOptions(plugin.WithHostProtocol(plugin.ProtocolV1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer h.Close()
+
+	if err := h.Init(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := h.Apply(ctx, &apiv1.ApplyRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if res.Done == nil || len(res.Checkpoints) != 0 {
+		t.Fatalf("expected no checkpoints for host speaking %s, got: %v", plugin.ProtocolV1, res.Checkpoints)
+	}
+}
+
+type dnsTestPlugin struct {
+	*testPlugin
+}
+
+func (p *dnsTestPlugin) PlanDNS(ctx context.Context, reg *registry.Registry, r *apiv1.PlanDNSRequest) (*apiv1.PlanDNSResponse, error) {
+	return &apiv1.PlanDNSResponse{}, nil
+}
+
+func (p *dnsTestPlugin) ApplyDNS(r *apiv1.ApplyDNSRequest, reg *registry.Registry, stream apiv1.DNSPluginService_ApplyDNSServer) error {
+	ctx := stream.Context()
+
+	err := p.register(reg, r.State)
+	if err != nil {
+		return err
+	}
+
+	diff, err := reg.ProcessAndDiff(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	err = reg.Apply(ctx, nil, diff, plugin.DefaultRegistryApplyDNSCallback(stream))
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&apiv1.ApplyDNSResponse{
+		Response: &apiv1.ApplyDNSResponse_Done{
+			Done: &apiv1.ApplyDNSDoneResponse{},
+		},
+	})
+}
+
+func TestApplyDNSCheckpoints(t *testing.T) {
+	ctx := context.Background()
+
+	h, err := plugintest.New(&dnsTestPlugin{&testPlugin{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer h.Close()
+
+	if err := h.Init(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := h.ApplyDNS(ctx, &apiv1.ApplyDNSRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res.Checkpoints) == 0 || len(res.Checkpoints[len(res.Checkpoints)-1].Registry) == 0 {
+		t.Fatal("expected DNS apply checkpoint with registry state")
+	}
+}
+
+func TestSavedPlan(t *testing.T) {
+	ctx := context.Background()
+
+	h, err := plugintest.New(&testPlugin{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer h.Close()
+
+	if err := h.Init(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	plan, err := h.Plan(ctx, &apiv1.PlanRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	saved := &apiv1.SavedPlan{Plan: plan.Plan, Fingerprint: plan.Fingerprint}
+
+	// Plan that doesn't match registry diff is rejected even if fingerprint matches.
+	tampered := &apiv1.SavedPlan{Plan: &apiv1.Plan{}, Fingerprint: plan.Fingerprint}
+
+	_, err = h.Apply(ctx, &apiv1.ApplyRequest{SavedPlan: tampered})
+	if status.Code(err) != codes.FailedPrecondition || !strings.Contains(err.Error(), "plan changed") {
+		t.Fatalf("expected saved plan error, got: %v", err)
+	}
+
+	res, err := h.Apply(ctx, &apiv1.ApplyRequest{SavedPlan: saved})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// State changed since plan was created.
+	_, err = h.Apply(ctx, &apiv1.ApplyRequest{State: res.Done.State, SavedPlan: saved})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected saved plan error, got: %v", err)
+	}
+}
+
+type blockingResource struct {
+	registry.ResourceBase
+
+	Name fields.StringInputField
+
+	started, release chan struct{}
+	panic            bool
+}
+
+func (o *blockingResource) GetName() string {
+	return o.Name.Any()
+}
+
+func (o *blockingResource) Create(ctx context.Context, meta any) error {
+	if o.panic {
+		panic("create failed")
+	}
+
+	if o.started != nil {
+		close(o.started)
+		<-o.release
+	}
+
+	return nil
+}
+
+func (o *blockingResource) Update(ctx context.Context, meta any) error {
+	return nil
+}
+
+func (o *blockingResource) Delete(ctx context.Context, meta any) error {
+	return nil
+}
+
+func TestCancelApply(t *testing.T) {
+	ctx := context.Background()
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	h, err := plugintest.New(&testPlugin{
+		resources: func(reg *registry.Registry) error {
+			first := &blockingResource{Name: fields.String("first"), started: started, release: release}
+
+			_, err := reg.RegisterPluginResource("test", "first", first)
+			if err != nil {
+				return err
+			}
+
+			_, err = reg.RegisterPluginResource("test", "second", &blockingResource{Name: first.Name})
+
+			return err
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer h.Close()
+
+	if err := h.Init(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	type result struct {
+		res *plugintest.ApplyResult[*apiv1.ApplyDoneResponse]
+		err error
+	}
+
+	resCh := make(chan result, 1)
+
+	go func() {
+		res, err := h.Apply(ctx, &apiv1.ApplyRequest{})
+		resCh <- result{res, err}
+	}()
+
+	<-started
+
+	cancelRes, err := h.Deploy.CancelApply(ctx, &apiv1.CancelApplyRequest{})
+	if err != nil || !cancelRes.Cancelled {
+		t.Fatalf("expected apply to be cancelled, got: %v, %v", cancelRes, err)
+	}
+
+	close(release)
+
+	res := <-resCh
+	if res.err != nil {
+		t.Fatal(res.err)
+	}
+
+	if res.res.Done == nil {
+		t.Fatal("expected done response with partial state")
+	}
+
+	state, err := registry.UnmarshalState(res.res.Done.State.Registry)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(state.Resources) != 1 || state.Resources[0].ID != "first" || state.Resources[0].IsNew {
+		t.Fatalf("expected only in-flight resource to be applied: %s", res.res.Done.State.Registry)
+	}
+}
+
+func TestCancelApplyBeforeLoad(t *testing.T) {
+	ctx := context.Background()
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	h, err := plugintest.New(&testPlugin{
+		resources: func(reg *registry.Registry) error {
+			close(started)
+			<-release
+
+			return errors.New("interrupted")
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer h.Close()
+
+	if err := h.Init(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	stored := types.NewPluginState()
+	stored.Registry = []byte(`{"version":1,"resources":[{"source":"plugin","namespace":"test","type":"blockingResource","id":"first"}]}`)
+
+	type result struct {
+		res *plugintest.ApplyResult[*apiv1.ApplyDoneResponse]
+		err error
+	}
+
+	resCh := make(chan result, 1)
+
+	go func() {
+		res, err := h.Apply(ctx, &apiv1.ApplyRequest{State: stored})
+		resCh <- result{res, err}
+	}()
+
+	<-started
+
+	_, err = h.Deploy.CancelApply(ctx, &apiv1.CancelApplyRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	close(release)
+
+	res := <-resCh
+	if res.err != nil {
+		t.Fatal(res.err)
+	}
+
+	if res.res.Done == nil || !bytes.Equal(res.res.Done.State.Registry, stored.Registry) {
+		t.Fatalf("expected stored state to be sent back unchanged, got: %v", res.res.Done)
+	}
+}
+
+func TestPanicRecovery(t *testing.T) {
+	ctx := context.Background()
+	host := plugintest.NewHost()
+
+	h, err := plugintest.New(&testPlugin{
+		resources: func(reg *registry.Registry) error {
+			_, err := reg.RegisterPluginResource("test", "panic", &blockingResource{Name: fields.String("panic"), panic: true})
+
+			return err
+		},
+	}, plugintest.WithHost(host), plugintest.WithServerOptions(plugin.WithRequestLogging()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer h.Close()
+
+	if err := h.Init(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = h.Apply(ctx, &apiv1.ApplyRequest{})
+
+	st := status.Convert(err)
+	if st.Code() != codes.Internal || !strings.Contains(st.Message(), "create failed") {
+		t.Fatalf("expected internal error from panic, got: %v", err)
+	}
+
+	if len(st.Details()) != 1 {
+		t.Fatalf("expected stack trace in details, got: %v", st.Details())
+	}
+
+	if _, ok := st.Details()[0].(*errdetails.DebugInfo); !ok {
+		t.Fatalf("expected debug info in details, got: %T", st.Details()[0])
+	}
+
+	// Plugin keeps serving after panic.
+	if err := h.Start(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	logs := strings.Join(host.LogMessages(apiv1.LogRequest_LEVEL_DEBUG), "")
+	if !strings.Contains(logs, "/api.v1.DeployPluginService/Apply failed") {
+		t.Fatalf("expected failed apply to be logged, got: %s", logs)
+	}
+}
+
+func TestHealth(t *testing.T) {
+	ctx := context.Background()
+
+	h, err := plugintest.New(&testPlugin{}, plugintest.WithServerOptions(plugin.WithHealthService()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer h.Close()
+
+	check := func(service string) healthpb.HealthCheckResponse_ServingStatus {
+		res, err := h.Health.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return res.Status
+	}
+
+	basic := apiv1.BasicPluginService_ServiceDesc.ServiceName
+
+	if check("") != healthpb.HealthCheckResponse_NOT_SERVING || check(basic) != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Fatal("expected plugin not to be serving before init")
+	}
+
+	if err := h.Init(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if check("") != healthpb.HealthCheckResponse_NOT_SERVING || check(basic) != healthpb.HealthCheckResponse_SERVING {
+		t.Fatal("expected only basic service to be serving after init")
+	}
+
+	if err := h.Start(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if check("") != healthpb.HealthCheckResponse_SERVING {
+		t.Fatal("expected plugin to be serving after start")
+	}
+}
+
+func TestStructuredLogging(t *testing.T) {
+	host := plugintest.NewHost()
+
+	log.NewLogger(host).With("app", "web", slog.Int("replicas", 2)).Infoln("deploying")
+	slog.New(log.NewSlogHandler(host, nil)).WithGroup("db").Debug("dropped")
+	slog.New(log.NewSlogHandler(host, nil)).WithGroup("db").Warn("slow query", "took", time.Second)
+
+	logs := host.Logs()
+	if len(logs) != 2 {
+		t.Fatalf("expected 2 log lines, got: %v", logs)
+	}
+
+	attrs := func(l *apiv1.LogRequest) map[string]any {
+		ret := make(map[string]any)
+
+		for _, a := range l.Attrs {
+			ret[a.Key] = a.Value.AsInterface()
+		}
+
+		return ret
+	}
+
+	if a := attrs(logs[0]); logs[0].Level != apiv1.LogRequest_LEVEL_INFO || a["app"] != "web" || a["replicas"] != 2.0 {
+		t.Fatalf("unexpected logger attributes: %v", logs[0])
+	}
+
+	if a := attrs(logs[1]); logs[1].Level != apiv1.LogRequest_LEVEL_WARN || logs[1].Message != "slow query" || a["db.took"] != "1s" {
+		t.Fatalf("unexpected slog attributes: %v", logs[1])
+	}
+}
+
+type unavailableHost struct {
+	*plugintest.Host
+}
+
+func (h *unavailableHost) LogStream(ctx context.Context, opts ...grpc.CallOption) (apiv1.HostService_LogStreamClient, error) {
+	return nil, status.Error(codes.Unavailable, "host unavailable")
+}
+
+func TestBufferedLogger(t *testing.T) {
+	host := plugintest.NewHost()
+
+	var fallback bytes.Buffer
+
+	l := log.NewBufferedLogger(host, &log.BufferedOptions{BatchSize: 2, Fallback: &fallback})
+
+	for i := range 5 {
+		l.With("i", i).Debugf("line %d", i)
+	}
+
+	l.Flush()
+
+	msgs := host.LogMessages(apiv1.LogRequest_LEVEL_DEBUG)
+	if strings.Join(msgs, ",") != "line 0,line 1,line 2,line 3,line 4" {
+		t.Fatalf("expected all lines in order, got: %v", msgs)
+	}
+
+	if host.Logs()[4].Attrs[0].Value.GetNumberValue() != 4 {
+		t.Fatalf("expected attributes to be sent, got: %v", host.Logs()[4])
+	}
+
+	l.Close()
+	l.Infoln("after close")
+
+	if fallback.String() != "INFO after close\n" {
+		t.Fatalf("expected line after close to be written to fallback, got: %q", fallback.String())
+	}
+}
+
+func TestBufferedLoggerFallback(t *testing.T) {
+	var fallback bytes.Buffer
+
+	l := log.NewBufferedLogger(&unavailableHost{plugintest.NewHost()}, &log.BufferedOptions{Fallback: &fallback})
+
+	l.With("app", "web").Warnln("host is gone")
+	l.Close()
+
+	if fallback.String() != "WARN host is gone app=web\n" {
+		t.Fatalf("expected undelivered line in fallback, got: %q", fallback.String())
+	}
+
+	fallback.Reset()
+
+	l = log.NewBufferedLogger(nil, &log.BufferedOptions{Fallback: &fallback})
+	l.Errorf("before init")
+	l.Close()
+
+	if fallback.String() != "ERROR before init\n" {
+		t.Fatalf("expected line without host in fallback, got: %q", fallback.String())
+	}
+}
+
+// legacyHost does not implement LogStream, as with real gRPC the error is returned only once stream is closed.
+type legacyHost struct {
+	*plugintest.Host
+}
+
+type unimplementedLogStream struct {
+	grpc.ClientStream
+}
+
+func (*unimplementedLogStream) Send(*apiv1.LogBatchRequest) error {
+	return nil
+}
+
+func (*unimplementedLogStream) CloseAndRecv() (*apiv1.LogResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "unknown method LogStream")
+}
+
+func (h *legacyHost) LogStream(ctx context.Context, opts ...grpc.CallOption) (apiv1.HostService_LogStreamClient, error) {
+	return &unimplementedLogStream{}, nil
+}
+
+func TestBufferedLoggerUnaryFallback(t *testing.T) {
+	host := &legacyHost{plugintest.NewHost()}
+
+	var fallback bytes.Buffer
+
+	l := log.NewBufferedLogger(host, &log.BufferedOptions{BatchSize: 2, Fallback: &fallback})
+
+	for i := range 5 {
+		l.Infof("line %d", i)
+	}
+
+	l.Close()
+
+	msgs := host.LogMessages(apiv1.LogRequest_LEVEL_INFO)
+	if strings.Join(msgs, ",") != "line 0,line 1,line 2,line 3,line 4" {
+		t.Fatalf("expected all lines sent with unary calls, got: %v", msgs)
+	}
+
+	if fallback.Len() != 0 {
+		t.Fatalf("expected no lines in fallback, got: %q", fallback.String())
+	}
+}
+
+// ackHost delivers lines sent over LogStream only once stream is closed, as a host may still process them until then.
+type ackHost struct {
+	*plugintest.Host
+}
+
+type ackLogStream struct {
+	grpc.ClientStream
+
+	host    *plugintest.Host
+	pending []*apiv1.LogRequest
+}
+
+func (s *ackLogStream) Send(r *apiv1.LogBatchRequest) error {
+	s.pending = append(s.pending, r.Logs...)
+
+	return nil
+}
+
+func (s *ackLogStream) CloseAndRecv() (*apiv1.LogResponse, error) {
+	for _, r := range s.pending {
+		_, _ = s.host.Log(context.Background(), r)
+	}
+
+	return &apiv1.LogResponse{}, nil
+}
+
+func (h *ackHost) LogStream(ctx context.Context, opts ...grpc.CallOption) (apiv1.HostService_LogStreamClient, error) {
+	return &ackLogStream{host: h.Host}, nil
+}
+
+func TestBufferedLoggerFlushWaitsForHost(t *testing.T) {
+	host := &ackHost{plugintest.NewHost()}
+
+	l := log.NewBufferedLogger(host, &log.BufferedOptions{FlushInterval: time.Hour})
+	defer l.Close()
+
+	for i := range 3 {
+		l.Errorf("line %d", i)
+		l.Flush()
+	}
+
+	msgs := host.LogMessages(apiv1.LogRequest_LEVEL_ERROR)
+	if strings.Join(msgs, ",") != "line 0,line 1,line 2" {
+		t.Fatalf("expected all lines to be received by host after flush, got: %v", msgs)
+	}
+}
+
+func TestHarnessCloseFlushesLogger(t *testing.T) {
+	ctx := context.Background()
+	p := &testPlugin{}
+
+	h, err := plugintest.New(p, plugintest.WithServerOptions(plugin.WithBufferedLogging(&log.BufferedOptions{FlushInterval: time.Hour})))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := h.Init(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	p.log.Warnln("before close")
+
+	if err := h.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if msgs := h.Host.LogMessages(apiv1.LogRequest_LEVEL_WARN); len(msgs) != 1 || msgs[0] != "before close\n" {
+		t.Fatalf("expected buffered line to be flushed on close, got: %q", msgs)
+	}
+}
+
+func TestBufferedLoggerSlogHandler(t *testing.T) {
+	host := plugintest.NewHost()
+
+	l := log.NewBufferedLogger(host, &log.BufferedOptions{FlushInterval: time.Hour})
+	defer l.Close()
+
+	slog.New(l.SlogHandler(nil)).With("app", "web").Info("queued", "n", 1)
+
+	if logs := host.Logs(); len(logs) != 0 {
+		t.Fatalf("expected slog record to be buffered, got: %v", logs)
+	}
+
+	l.Flush()
+
+	logs := host.Logs()
+	if len(logs) != 1 || logs[0].Message != "queued" || len(logs[0].Attrs) != 2 || logs[0].Attrs[0].Key != "app" {
+		t.Fatalf("expected slog record with attributes after flush, got: %v", logs)
+	}
+}
+
+func TestHostScriptedAnswers(t *testing.T) {
+	ctx := context.Background()
+	host := plugintest.NewHost().AnswerConfirmation(true).SetSecret("key", "value")
+
+	res, err := host.PromptConfirmation(ctx, &apiv1.PromptConfirmationRequest{})
+	if err != nil || !res.Confirmed {
+		t.Fatalf("expected scripted confirmation, got: %v, %v", res, err)
+	}
+
+	res, err = host.PromptConfirmation(ctx, &apiv1.PromptConfirmationRequest{Default: false})
+	if err != nil || res.Confirmed {
+		t.Fatalf("expected default confirmation, got: %v, %v", res, err)
+	}
+
+	sec, err := host.HostGetSecret(ctx, &apiv1.HostGetSecretRequest{Key: "key"})
+	if err != nil || !sec.Specified || sec.Value != "value" {
+		t.Fatalf("unexpected secret: %v, %v", sec, err)
+	}
+}
@@ -0,0 +1,158 @@
+package registry
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+const defaultCheckpointInterval = 2 * time.Second
+
+// checkpointer keeps serialized snapshot of every resource and periodically passes registry dump to hook.
+// Each resource is only serialized by goroutine applying it so that in-flight resources are never read concurrently.
+type checkpointer struct {
+	mu       sync.Mutex
+	hookMu   sync.Locker
+	hook     func([]byte)
+	marshal  func([]*ResourceSerialized) ([]byte, error)
+	interval time.Duration
+	entries  map[ResourceID]*ResourceSerialized
+	timer    *time.Timer
+	dirty    bool
+	closed   bool
+	err      error
+}
+
+func newCheckpointer(resources map[ResourceID]*ResourceWrapper, hook func([]byte), marshal func([]*ResourceSerialized) ([]byte, error), interval time.Duration, hookMu sync.Locker) *checkpointer {
+	if interval <= 0 {
+		interval = defaultCheckpointInterval
+	}
+
+	c := &checkpointer{
+		hookMu:   hookMu,
+		hook:     hook,
+		marshal:  marshal,
+		interval: interval,
+		entries:  make(map[ResourceID]*ResourceSerialized, len(resources)),
+	}
+
+	for _, rw := range resources {
+		c.set(rw)
+	}
+
+	return c
+}
+
+// set updates snapshot of resource. If resource cannot be serialized, its stale snapshot is dropped
+// and error is recorded to be returned on Close.
+func (c *checkpointer) set(rw *ResourceWrapper) {
+	if rw.Resource.IsDeleted() || rw.Resource.SkipState() {
+		delete(c.entries, rw.ResourceID)
+
+		return
+	}
+
+	// Dependencies are filtered during dump using latest snapshots of other resources.
+	rs, err := rw.serialize(func(*ResourceWrapper) bool { return true })
+	if err != nil {
+		delete(c.entries, rw.ResourceID)
+		c.setErr(fmt.Errorf("checkpoint of %s error: %w", rw, err))
+
+		return
+	}
+
+	c.entries[rw.ResourceID] = rs
+}
+
+func (c *checkpointer) setErr(err error) {
+	if c.err == nil {
+		c.err = err
+	}
+}
+
+func (c *checkpointer) Update(rw *ResourceWrapper) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.set(rw)
+	c.dirty = true
+
+	if c.timer == nil && !c.closed {
+		c.timer = time.AfterFunc(c.interval, c.flush)
+	}
+}
+
+func filterExisting(ids []ResourceID, entries map[ResourceID]*ResourceSerialized) []ResourceID {
+	var ret []ResourceID
+
+	for _, id := range ids {
+		if e, ok := entries[id]; ok && !e.IsNew {
+			ret = append(ret, id)
+		}
+	}
+
+	return ret
+}
+
+func (c *checkpointer) dump() ([]byte, error) {
+	list := make([]*ResourceSerialized, 0, len(c.entries))
+
+	for _, e := range c.entries {
+		rs := *e
+		rs.Dependencies = filterExisting(e.Dependencies, c.entries)
+		rs.DependedBy = filterExisting(e.DependedBy, c.entries)
+
+		list = append(list, &rs)
+	}
+
+	return c.marshal(list)
+}
+
+func (c *checkpointer) flush() {
+	c.hookMu.Lock()
+	defer c.hookMu.Unlock()
+
+	c.mu.Lock()
+
+	c.timer = nil
+
+	if !c.dirty {
+		c.mu.Unlock()
+
+		return
+	}
+
+	c.dirty = false
+
+	data, err := c.dump()
+	if err != nil {
+		c.setErr(fmt.Errorf("checkpoint error: %w", err))
+	}
+
+	c.mu.Unlock()
+
+	if err == nil {
+		c.hook(data)
+	}
+}
+
+// Close stops pending checkpoint, flushes any remaining changes and returns first error that happened during checkpointing.
+func (c *checkpointer) Close() error {
+	c.mu.Lock()
+
+	c.closed = true
+
+	if c.timer != nil {
+		c.timer.Stop()
+		c.timer = nil
+	}
+
+	c.mu.Unlock()
+
+	c.flush()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.err
+}
@@ -0,0 +1,137 @@
+package registry
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DependencyCycleError is returned when resources depend on each other.
+// Edges form a chain where last edge points back to the first resource.
+type DependencyCycleError struct {
+	Edges []*GraphEdge
+}
+
+func (e *DependencyCycleError) Error() string {
+	var b strings.Builder
+
+	b.WriteString("dependency cycle detected: ")
+
+	for i, edge := range e.Edges {
+		if i == 0 {
+			b.WriteString(resourceIDPath(&edge.From))
+		}
+
+		if len(edge.Fields) > 0 {
+			fmt.Fprintf(&b, " -(%s)-> %s", strings.Join(edge.Fields, ","), resourceIDPath(&edge.To))
+		} else {
+			fmt.Fprintf(&b, " -> %s", resourceIDPath(&edge.To))
+		}
+	}
+
+	return b.String()
+}
+
+func sortedResources(m map[*ResourceWrapper]struct{}) []*ResourceWrapper {
+	ret := make([]*ResourceWrapper, 0, len(m))
+
+	for rw := range m {
+		ret = append(ret, rw)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Less(&ret[j].ResourceID)
+	})
+
+	return ret
+}
+
+// checkCycles validates that dependencies of processed resources can be topologically sorted.
+func (r *Registry) checkCycles() error {
+	const (
+		visiting = iota + 1
+		visited
+	)
+
+	state := make(map[*ResourceWrapper]int, len(r.resources))
+	all := make(map[*ResourceWrapper]struct{}, len(r.resources))
+
+	for _, rw := range r.resources {
+		all[rw] = struct{}{}
+	}
+
+	var (
+		path  []*ResourceWrapper
+		visit func(rw *ResourceWrapper) error
+	)
+
+	visit = func(rw *ResourceWrapper) error {
+		state[rw] = visiting
+		path = append(path, rw)
+
+		for _, dep := range sortedResources(rw.Dependencies) {
+			if _, ok := r.resources[dep.ResourceID]; !ok {
+				continue
+			}
+
+			switch state[dep] {
+			case visited:
+				continue
+			case visiting:
+				return r.newDependencyCycleError(path, dep)
+			}
+
+			err := visit(dep)
+			if err != nil {
+				return err
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[rw] = visited
+
+		return nil
+	}
+
+	for _, rw := range sortedResources(all) {
+		if state[rw] != 0 {
+			continue
+		}
+
+		err := visit(rw)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Registry) newDependencyCycleError(path []*ResourceWrapper, start *ResourceWrapper) error {
+	var i int
+
+	for i = range path {
+		if path[i] == start {
+			break
+		}
+	}
+
+	cycle := make([]*ResourceWrapper, 0, len(path)-i+1)
+	cycle = append(cycle, path[i:]...)
+	cycle = append(cycle, start)
+
+	err := &DependencyCycleError{}
+
+	for j := 0; j < len(cycle)-1; j++ {
+		names, hardLink := r.dependencyFields(cycle[j], cycle[j+1])
+
+		err.Edges = append(err.Edges, &GraphEdge{
+			From:     cycle[j].ResourceID,
+			To:       cycle[j+1].ResourceID,
+			Fields:   names,
+			HardLink: hardLink,
+		})
+	}
+
+	return err
+}
@@ -2,9 +2,13 @@ package registry
 
 import (
 	"context"
+	"fmt"
+	"sort"
 
 	apiv1 "github.com/outblocks/outblocks-plugin-go/gen/api/v1"
+	"github.com/outblocks/outblocks-plugin-go/registry/fields"
 	"github.com/outblocks/outblocks-plugin-go/types"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 type DiffType int
@@ -18,11 +22,41 @@ const (
 	DiffTypeProcess
 )
 
+func (t DiffType) String() string {
+	switch t {
+	case DiffTypeNone:
+		return "none"
+	case DiffTypeCreate:
+		return "create"
+	case DiffTypeUpdate:
+		return "update"
+	case DiffTypeRecreate:
+		return "recreate"
+	case DiffTypeDelete:
+		return "delete"
+	case DiffTypeProcess:
+		return "process"
+	}
+
+	return "unknown"
+}
+
+type FieldChange struct {
+	Name       string
+	Old, New   any
+	OldDefined bool
+	NewDefined bool
+	ForceNew   bool
+	Computed   bool // new value is not known until apply
+	Sensitive  bool // values are masked in plan output
+}
+
 type Diff struct {
-	Object   *ResourceWrapper
-	Type     DiffType
-	Fields   []string
-	Critical bool
+	Object       *ResourceWrapper
+	Type         DiffType
+	Fields       []string
+	FieldChanges []*FieldChange
+	Critical     bool
 
 	applied []chan struct{}
 }
@@ -123,22 +157,30 @@ func (d *Diff) ObjectType() string {
 }
 
 func (d *Diff) ToPlanAction() *apiv1.PlanAction {
+	var action *apiv1.PlanAction
+
 	switch d.Type {
 	case DiffTypeCreate:
-		return types.NewPlanActionCreate(d.Object.Source, d.Object.Namespace, d.Object.ID, d.ObjectType(), d.Object.Resource.GetName(), d.Fields, d.Critical)
+		action = types.NewPlanActionCreate(d.Object.Source, d.Object.Namespace, d.Object.ID, d.ObjectType(), d.Object.Resource.GetName(), d.Fields, d.Critical)
 	case DiffTypeUpdate:
-		return types.NewPlanActionUpdate(d.Object.Source, d.Object.Namespace, d.Object.ID, d.ObjectType(), d.Object.Resource.GetName(), d.Fields, d.Critical)
+		action = types.NewPlanActionUpdate(d.Object.Source, d.Object.Namespace, d.Object.ID, d.ObjectType(), d.Object.Resource.GetName(), d.Fields, d.Critical)
 	case DiffTypeRecreate:
-		return types.NewPlanActionRecreate(d.Object.Source, d.Object.Namespace, d.Object.ID, d.ObjectType(), d.Object.Resource.GetName(), d.Fields, d.Critical)
+		action = types.NewPlanActionRecreate(d.Object.Source, d.Object.Namespace, d.Object.ID, d.ObjectType(), d.Object.Resource.GetName(), d.Fields, d.Critical)
 	case DiffTypeDelete:
-		return types.NewPlanActionDelete(d.Object.Source, d.Object.Namespace, d.Object.ID, d.ObjectType(), d.Object.Resource.GetName(), d.Fields, d.Critical)
+		action = types.NewPlanActionDelete(d.Object.Source, d.Object.Namespace, d.Object.ID, d.ObjectType(), d.Object.Resource.GetName(), d.Fields, d.Critical)
 	case DiffTypeProcess:
-		return types.NewPlanActionProcess(d.Object.Source, d.Object.Namespace, d.Object.ID, d.ObjectType(), d.Object.Resource.GetName(), d.Fields, d.Critical)
+		action = types.NewPlanActionProcess(d.Object.Source, d.Object.Namespace, d.Object.ID, d.ObjectType(), d.Object.Resource.GetName(), d.Fields, d.Critical)
 	case DiffTypeNone:
 		panic("unexpected diff type")
 	default:
 		panic("unknown diff type")
 	}
+
+	for _, fc := range d.FieldChanges {
+		action.FieldChanges = append(action.FieldChanges, fc.ToPlanFieldChange())
+	}
+
+	return action
 }
 
 func (d *Diff) ToApplyAction(step, total int) *apiv1.ApplyAction {
@@ -183,6 +225,7 @@ func deleteObjectTree(r *ResourceWrapper, diffMap map[*ResourceWrapper]*Diff, on
 	}
 
 	diff := NewDiff(r, DiffTypeDelete, r.FieldList())
+	diff.FieldChanges = deleteFieldChanges(r, r.FieldList())
 	diffMap[r] = diff
 
 	r.Resource.setDiff(diff)
@@ -196,3 +239,127 @@ func PlanActionFromDiff(diff []*Diff) []*apiv1.PlanAction {
 
 	return actions
 }
+
+func newFieldChange(rw *ResourceWrapper, name string, field *FieldInfo, forceNew, unknown bool) *FieldChange {
+	fc := &FieldChange{
+		Name:      name,
+		ForceNew:  forceNew,
+		Computed:  unknown,
+		Sensitive: rw.isSensitiveField(field),
+	}
+
+	f := field.Value.Interface()
+
+	fc.Old, fc.OldDefined = fields.LookupCurrentValue(f)
+
+	if unknown {
+		return fc
+	}
+
+	if fld, ok := f.(fields.Field); ok && fld.IsOutput() {
+		fc.Computed = true
+
+		return fc
+	}
+
+	fc.New, fc.NewDefined = fields.LookupWantedValue(f)
+	if fc.NewDefined {
+		return fc
+	}
+
+	// Wanted value that cannot be resolved yet depends on values computed during apply.
+	if fdh, ok := f.(fields.FieldDependencyHolder); ok && len(fdh.FieldDependencies()) > 0 {
+		fc.Computed = true
+	}
+
+	if wd, ok := f.(interface{ IsWantedDefined() bool }); ok && wd.IsWantedDefined() {
+		fc.Computed = true
+	}
+
+	return fc
+}
+
+func newFieldChanges(rw *ResourceWrapper, fieldList []string) []*FieldChange {
+	var ret []*FieldChange
+
+	for _, name := range fieldList {
+		f, ok := rw.Fields[name]
+		if !ok || f.Type.Properties.Ignored || f.Value.IsNil() {
+			continue
+		}
+
+		fc := newFieldChange(rw, name, f, f.Type.Properties.ForceNew, false)
+		if !fc.OldDefined && !fc.NewDefined && !fc.Computed {
+			continue
+		}
+
+		ret = append(ret, fc)
+	}
+
+	sortFieldChanges(ret)
+
+	return ret
+}
+
+// deleteFieldChanges returns changes of deleted resource, only old values are set. Values taken from sensitive
+// fields of other resources are masked as well.
+func deleteFieldChanges(rw *ResourceWrapper, fieldList []string) []*FieldChange {
+	var ret []*FieldChange
+
+	for _, name := range fieldList {
+		f, ok := rw.Fields[name]
+		if !ok || f.Type.Properties.Ignored || f.Value.IsNil() {
+			continue
+		}
+
+		fc := &FieldChange{
+			Name:      name,
+			Sensitive: rw.isSensitiveField(f),
+		}
+
+		fc.Old, fc.OldDefined = fields.LookupCurrentValue(f.Value.Interface())
+		if !fc.OldDefined {
+			continue
+		}
+
+		ret = append(ret, fc)
+	}
+
+	sortFieldChanges(ret)
+
+	return ret
+}
+
+func sortFieldChanges(fc []*FieldChange) {
+	sort.Slice(fc, func(i, j int) bool {
+		return fc[i].Name < fc[j].Name
+	})
+}
+
+func planValue(v any, defined, sensitive bool) *structpb.Value {
+	if !defined {
+		return nil
+	}
+
+	if sensitive {
+		return structpb.NewStringValue(SensitiveValueMask)
+	}
+
+	val, err := structpb.NewValue(v)
+	if err != nil {
+		return structpb.NewStringValue(fmt.Sprint(v))
+	}
+
+	return val
+}
+
+func (fc *FieldChange) ToPlanFieldChange() *apiv1.PlanFieldChange {
+	return &apiv1.PlanFieldChange{
+		Name:      fc.Name,
+		OldValue:  planValue(fc.Old, fc.OldDefined, fc.Sensitive),
+		NewValue:  planValue(fc.New, fc.NewDefined, fc.Sensitive),
+		ForceNew:  fc.ForceNew,
+		Computed:  fc.Computed,
+		Sensitive: fc.Sensitive,
+	}
+}
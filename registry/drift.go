@@ -0,0 +1,165 @@
+package registry
+
+import (
+	"context"
+	"reflect"
+	"sort"
+
+	apiv1 "github.com/outblocks/outblocks-plugin-go/gen/api/v1"
+	"github.com/outblocks/outblocks-plugin-go/registry/fields"
+)
+
+// Drift describes differences between stored state of resource and its actual state.
+// Old value of field change is the stored one, new value is the one returned by Read.
+type Drift struct {
+	Object       *ResourceWrapper
+	Missing      bool
+	FieldChanges []*FieldChange
+}
+
+func (d *Drift) ObjectType() string {
+	typ := d.Object.Type
+
+	v, ok := d.Object.Resource.(ResourceTypeVerbose)
+	if ok {
+		typ = v.GetType()
+	}
+
+	return typ
+}
+
+func (d *Drift) ToResourceDrift() *apiv1.ResourceDrift {
+	ret := &apiv1.ResourceDrift{
+		Resource: &apiv1.ResourceID{
+			Source:    d.Object.Source,
+			Namespace: d.Object.Namespace,
+			Type:      d.Object.Type,
+			Id:        d.Object.ID,
+		},
+		ObjectType: d.ObjectType(),
+		ObjectName: d.Object.Resource.GetName(),
+		Missing:    d.Missing,
+	}
+
+	for _, fc := range d.FieldChanges {
+		ret.FieldChanges = append(ret.FieldChanges, fc.ToPlanFieldChange())
+	}
+
+	return ret
+}
+
+func ResourceDriftFromDrift(drift []*Drift) []*apiv1.ResourceDrift {
+	ret := make([]*apiv1.ResourceDrift, len(drift))
+	for i, d := range drift {
+		ret[i] = d.ToResourceDrift()
+	}
+
+	return ret
+}
+
+func currentFieldValues(rw *ResourceWrapper) map[string]any {
+	ret := make(map[string]any)
+
+	for k, v := range rw.Fields {
+		if v.Type.Properties.Ignored || v.Value.IsNil() {
+			continue
+		}
+
+		f := v.Value.Interface().(fields.Field) //nolint:errcheck
+
+		val, ok := f.LookupCurrentRaw()
+		if !ok {
+			continue
+		}
+
+		ret[k] = f.Serialize(val)
+	}
+
+	return ret
+}
+
+func driftFieldChanges(rw *ResourceWrapper, stored, actual map[string]any) []*FieldChange {
+	var ret []*FieldChange
+
+	for k, f := range rw.Fields {
+		oldVal, oldOk := stored[k]
+		newVal, newOk := actual[k]
+
+		if oldOk == newOk && reflect.DeepEqual(oldVal, newVal) {
+			continue
+		}
+
+		ret = append(ret, &FieldChange{
+			Name:       k,
+			Old:        oldVal,
+			New:        newVal,
+			OldDefined: oldOk,
+			NewDefined: newOk,
+			Sensitive:  rw.isSensitiveField(f),
+		})
+	}
+
+	sortFieldChanges(ret)
+
+	return ret
+}
+
+// Drift processes registry with read enabled and reports resources with state that differs from stored one.
+// It should be called after Load instead of Process. Only existing resources implementing ResourceReader are checked.
+func (r *Registry) Drift(ctx context.Context, meta any) ([]*Drift, error) {
+	r.checkResources(r.resources)
+
+	stored := make(map[*ResourceWrapper]map[string]any)
+	targeted := r.targeted()
+
+	for _, rw := range r.resources {
+		if rw.IsSkipped || rw.Partition != r.partition || !rw.Resource.IsExisting() {
+			continue
+		}
+
+		if _, ok := targeted[rw]; targeted != nil && !ok {
+			continue
+		}
+
+		if _, ok := rw.Resource.(ResourceReader); !ok {
+			continue
+		}
+
+		stored[rw] = currentFieldValues(rw)
+	}
+
+	read := r.opts.Read
+	r.opts.Read = true
+
+	err := r.Process(ctx, meta)
+
+	r.opts.Read = read
+
+	if err != nil {
+		return nil, err
+	}
+
+	var drift []*Drift
+
+	for rw, vals := range stored {
+		if _, ok := r.resources[rw.ResourceID]; !ok || rw.Resource.IsDeleted() {
+			continue
+		}
+
+		if rw.Resource.IsNew() {
+			drift = append(drift, &Drift{Object: rw, Missing: true})
+
+			continue
+		}
+
+		if fc := driftFieldChanges(rw, vals, currentFieldValues(rw)); len(fc) > 0 {
+			drift = append(drift, &Drift{Object: rw, FieldChanges: fc})
+		}
+	}
+
+	sort.Slice(drift, func(i, j int) bool {
+		return drift[i].Object.Less(&drift[j].Object.ResourceID)
+	})
+
+	return drift, nil
+}
@@ -0,0 +1,102 @@
+package registry
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const encryptedValuePrefix = "$enc:"
+
+type StateEncrypter interface {
+	Encrypt(plaintext []byte) ([]byte, error)
+	Decrypt(ciphertext []byte) ([]byte, error)
+}
+
+type AESStateEncrypter struct {
+	aead cipher.AEAD
+}
+
+// NewAESStateEncrypter creates AES-GCM based encrypter. Key has to be 16, 24 or 32 bytes long.
+func NewAESStateEncrypter(key []byte) (*AESStateEncrypter, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AESStateEncrypter{aead: aead}, nil
+}
+
+func (e *AESStateEncrypter) Encrypt(plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, e.aead.NonceSize())
+
+	_, err := rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func (e *AESStateEncrypter) Decrypt(ciphertext []byte) ([]byte, error) {
+	n := e.aead.NonceSize()
+	if len(ciphertext) < n {
+		return nil, errors.New("ciphertext too short")
+	}
+
+	return e.aead.Open(nil, ciphertext[:n], ciphertext[n:], nil)
+}
+
+var _ StateEncrypter = (*AESStateEncrypter)(nil)
+
+func encryptValue(enc StateEncrypter, v any) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	out, err := enc.Encrypt(data)
+	if err != nil {
+		return "", err
+	}
+
+	return encryptedValuePrefix + base64.StdEncoding.EncodeToString(out), nil
+}
+
+func isEncryptedValue(v any) bool {
+	s, ok := v.(string)
+
+	return ok && strings.HasPrefix(s, encryptedValuePrefix)
+}
+
+func decryptValue(enc StateEncrypter, v any) (any, error) {
+	if enc == nil {
+		return nil, errors.New("encrypted value found in state but no state encrypter is configured")
+	}
+
+	data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(v.(string), encryptedValuePrefix)) //nolint:errcheck
+	if err != nil {
+		return nil, fmt.Errorf("invalid encrypted value: %w", err)
+	}
+
+	data, err = enc.Decrypt(data)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decrypt value: %w", err)
+	}
+
+	var ret any
+
+	err = json.Unmarshal(data, &ret)
+
+	return ret, err
+}
@@ -19,10 +19,18 @@ type FieldInfo struct {
 }
 
 type FieldProperties struct {
-	Ignored  bool // ignore from state
-	ForceNew bool // any change of this field forces new resource
-	Computed bool // computed field disallows user input and is created by resource itself
-	HardLink bool // dependencies of this field cannot be removed (propagates recreate)
+	Ignored   bool // ignore from state
+	ForceNew  bool // any change of this field forces new resource
+	Computed  bool // computed field disallows user input and is created by resource itself
+	HardLink  bool // dependencies of this field cannot be removed (propagates recreate)
+	Sensitive bool // value is masked in plans and string output and encrypted in state if encrypter is set
+
+	IgnoreChanges bool // changes of this field are ignored once resource exists
+}
+
+// ResourceProperties are parsed from state tag of embedded ResourceBase.
+type ResourceProperties struct {
+	PreventDestroy bool // diff fails instead of deleting or recreating resource
 }
 
 func parseFieldPropertiesTag(tag string) *FieldProperties {
@@ -47,6 +55,12 @@ func parseFieldPropertiesTag(tag string) *FieldProperties {
 
 		case "hard", "hard_link":
 			ret.HardLink = true
+
+		case "sensitive":
+			ret.Sensitive = true
+
+		case "ignore_changes":
+			ret.IgnoreChanges = true
 		default:
 			panic(fmt.Sprintf("unknown field properties tag: %s", t))
 		}
@@ -54,3 +68,22 @@ func parseFieldPropertiesTag(tag string) *FieldProperties {
 
 	return ret
 }
+
+func parseResourcePropertiesTag(tag string) *ResourceProperties {
+	ret := &ResourceProperties{}
+
+	if tag == "" {
+		return ret
+	}
+
+	for _, t := range strings.Split(tag, ",") {
+		switch t {
+		case "prevent_destroy":
+			ret.PreventDestroy = true
+		default:
+			panic(fmt.Sprintf("unknown resource properties tag: %s", t))
+		}
+	}
+
+	return ret
+}
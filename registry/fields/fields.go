@@ -243,3 +243,81 @@ func SetFieldValue(f, v any) error {
 
 	return nil
 }
+
+// SetWantedValue sets wanted value of input field from value returned by LookupCurrentValue.
+// It returns error if value doesn't match type of field.
+func SetWantedValue(f, v any) error {
+	ok := true
+
+	switch val := f.(type) {
+	case StringInputField:
+		var s string
+		if s, ok = v.(string); ok {
+			val.SetWanted(s)
+		}
+	case BoolInputField:
+		var b bool
+		if b, ok = v.(bool); ok {
+			val.SetWanted(b)
+		}
+	case IntInputField:
+		var i int
+		if i, ok = toInt(v); ok {
+			val.SetWanted(i)
+		}
+	case MapInputField:
+		var m map[string]any
+		if m, ok = v.(map[string]any); ok {
+			val.SetWanted(m)
+		}
+	case ArrayInputField:
+		var a []any
+		if a, ok = v.([]any); ok {
+			val.SetWanted(a)
+		}
+	default:
+		return fmt.Errorf("unknown input field type found: %+v", f)
+	}
+
+	if !ok {
+		return fmt.Errorf("invalid value type %T for field: %+v", v, f)
+	}
+
+	return nil
+}
+
+// LookupCurrentValue returns current value of any field in its serialized form.
+func LookupCurrentValue(f any) (any, bool) {
+	switch v := f.(type) {
+	case stringField:
+		return v.LookupCurrent()
+	case boolField:
+		return v.LookupCurrent()
+	case intField:
+		return v.LookupCurrent()
+	case mapField:
+		return v.LookupCurrent()
+	case arrayField:
+		return v.LookupCurrent()
+	}
+
+	return nil, false
+}
+
+// LookupWantedValue returns wanted value of input field in its serialized form.
+func LookupWantedValue(f any) (any, bool) {
+	switch v := f.(type) {
+	case StringInputField:
+		return v.LookupWanted()
+	case BoolInputField:
+		return v.LookupWanted()
+	case IntInputField:
+		return v.LookupWanted()
+	case MapInputField:
+		return v.LookupWanted()
+	case ArrayInputField:
+		return v.LookupWanted()
+	}
+
+	return nil, false
+}
@@ -0,0 +1,41 @@
+package fields_test
+
+import (
+	"testing"
+
+	"github.com/outblocks/outblocks-plugin-go/registry/fields"
+)
+
+func TestSetWantedValue(t *testing.T) {
+	tests := []struct {
+		field any
+		value any
+		err   bool
+	}{
+		{field: fields.String(""), value: "abc"},
+		{field: fields.String(""), value: 1, err: true},
+		{field: fields.Bool(false), value: true},
+		{field: fields.Bool(false), value: "true", err: true},
+		{field: fields.Int(0), value: float64(2)},
+		{field: fields.Int(0), value: "2", err: true},
+		{field: fields.Map(nil), value: map[string]any{"a": 1}},
+		{field: fields.Map(nil), value: []any{1}, err: true},
+		{field: fields.Array(nil), value: []any{1}},
+		{field: fields.Array(nil), value: map[string]any{}, err: true},
+	}
+
+	for _, test := range tests {
+		err := fields.SetWantedValue(test.field, test.value)
+		if (err != nil) != test.err {
+			t.Fatalf("SetWantedValue(%T, %#v): unexpected error: %v", test.field, test.value, err)
+		}
+
+		if err != nil {
+			continue
+		}
+
+		if v, ok := fields.LookupWantedValue(test.field); !ok || v == nil {
+			t.Fatalf("SetWantedValue(%T, %#v): wanted value not set", test.field, test.value)
+		}
+	}
+}
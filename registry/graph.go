@@ -0,0 +1,186 @@
+package registry
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/outblocks/outblocks-plugin-go/registry/fields"
+)
+
+type GraphNode struct {
+	ResourceID
+	ObjectType string `json:"object_type"`
+	ObjectName string `json:"object_name"`
+	Diff       string `json:"diff"`
+	Existing   bool   `json:"existing"`
+}
+
+// GraphEdge points from resource to its dependency.
+type GraphEdge struct {
+	From     ResourceID `json:"from"`
+	To       ResourceID `json:"to"`
+	Fields   []string   `json:"fields,omitempty"`
+	HardLink bool       `json:"hard_link,omitempty"`
+}
+
+type Graph struct {
+	Nodes []*GraphNode `json:"nodes"`
+	Edges []*GraphEdge `json:"edges"`
+}
+
+// dependencyFields returns fields of rw that depend on dep and whether any of them is a hard link.
+func (r *Registry) dependencyFields(rw, dep *ResourceWrapper) (names []string, hardLink bool) {
+	for name, f := range rw.Fields {
+		if f.Type.Properties.Ignored || f.Value.IsNil() {
+			continue
+		}
+
+		fdh, ok := f.Value.Interface().(fields.FieldDependencyHolder)
+		if !ok {
+			continue
+		}
+
+		for _, fd := range fdh.FieldDependencies() {
+			if r.fieldMap[fd] != dep {
+				continue
+			}
+
+			names = append(names, name)
+			hardLink = hardLink || f.Type.Properties.HardLink
+
+			break
+		}
+	}
+
+	sort.Strings(names)
+
+	return names, hardLink
+}
+
+// Graph returns dependency graph of resources. Diff type of nodes is only known after Diff was calculated.
+func (r *Registry) Graph() *Graph {
+	g := &Graph{
+		Nodes: make([]*GraphNode, 0, len(r.resources)),
+		Edges: []*GraphEdge{},
+	}
+
+	for _, rw := range r.resources {
+		diff := "none"
+
+		if d := rw.Resource.Diff(); d != nil {
+			diff = d.Type.String()
+		}
+
+		typ := rw.Type
+		if v, ok := rw.Resource.(ResourceTypeVerbose); ok {
+			typ = v.GetType()
+		}
+
+		g.Nodes = append(g.Nodes, &GraphNode{
+			ResourceID: rw.ResourceID,
+			ObjectType: typ,
+			ObjectName: rw.Resource.GetName(),
+			Diff:       diff,
+			Existing:   rw.Resource.IsExisting(),
+		})
+
+		for dep := range rw.Dependencies {
+			names, hardLink := r.dependencyFields(rw, dep)
+
+			g.Edges = append(g.Edges, &GraphEdge{
+				From:     rw.ResourceID,
+				To:       dep.ResourceID,
+				Fields:   names,
+				HardLink: hardLink,
+			})
+		}
+	}
+
+	sort.Slice(g.Nodes, func(i, j int) bool {
+		return g.Nodes[i].Less(&g.Nodes[j].ResourceID)
+	})
+
+	sort.Slice(g.Edges, func(i, j int) bool {
+		if g.Edges[i].From != g.Edges[j].From {
+			return g.Edges[i].From.Less(&g.Edges[j].From)
+		}
+
+		return g.Edges[i].To.Less(&g.Edges[j].To)
+	})
+
+	return g
+}
+
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+func dotQuote(lines ...string) string {
+	for i, l := range lines {
+		lines[i] = dotEscaper.Replace(l)
+	}
+
+	return `"` + strings.Join(lines, `\n`) + `"`
+}
+
+func resourceIDPath(id *ResourceID) string {
+	return fmt.Sprintf("%s/%s/%s/%s", id.Source, id.Namespace, id.Type, id.ID)
+}
+
+func graphNodeID(id *ResourceID) string {
+	return dotQuote(resourceIDPath(id))
+}
+
+var graphDiffColors = map[string]string{
+	"create":   "green",
+	"update":   "orange",
+	"recreate": "red",
+	"delete":   "red",
+	"process":  "blue",
+}
+
+// WriteDOT renders graph in Graphviz DOT format.
+func (g *Graph) WriteDOT(w io.Writer) error {
+	var b strings.Builder
+
+	b.WriteString("digraph registry {\n\trankdir=LR;\n\tnode [shape=box];\n")
+
+	for _, n := range g.Nodes {
+		attrs := "label=" + dotQuote(n.ObjectType, n.ID, "("+n.Diff+")")
+
+		if color, ok := graphDiffColors[n.Diff]; ok {
+			attrs += fmt.Sprintf(", color=%s", color)
+		}
+
+		if !n.Existing {
+			attrs += ", style=dashed"
+		}
+
+		fmt.Fprintf(&b, "\t%s [%s];\n", graphNodeID(&n.ResourceID), attrs)
+	}
+
+	for _, e := range g.Edges {
+		attrs := "label=" + dotQuote(strings.Join(e.Fields, ","))
+
+		if e.HardLink {
+			attrs += ", style=bold, color=red"
+		}
+
+		fmt.Fprintf(&b, "\t%s -> %s [%s];\n", graphNodeID(&e.From), graphNodeID(&e.To), attrs)
+	}
+
+	b.WriteString("}\n")
+
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
+
+// WriteJSON renders graph as indented JSON.
+func (g *Graph) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(g)
+}
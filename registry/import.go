@@ -0,0 +1,73 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+
+	apiv1 "github.com/outblocks/outblocks-plugin-go/gen/api/v1"
+)
+
+// ResourceImporter allows resource to be adopted from existing cloud object identified by external ID.
+// Import is expected to fill current values of fields.
+type ResourceImporter interface {
+	Import(ctx context.Context, meta any, externalID string) error
+}
+
+func ResourceIDFromProto(id *apiv1.ResourceID) ResourceID {
+	return ResourceID{
+		Source:    id.GetSource(),
+		Namespace: id.GetNamespace(),
+		Type:      id.GetType(),
+		ID:        id.GetId(),
+	}
+}
+
+// Import adopts existing cloud object as registered resource so that it is persisted in Dump.
+// Resource has to be registered and processed first. If it doesn't implement ResourceImporter,
+// Read is used instead and resource fields registered have to identify cloud object, externalID
+// has to be empty in that case as it cannot be passed to Read.
+func (r *Registry) Import(ctx context.Context, meta any, resourceID ResourceID, externalID string) error { //nolint:gocritic
+	if resourceID.Partition == "" {
+		resourceID.Partition = r.partition
+	}
+
+	rw, ok := r.resources[resourceID]
+	if !ok || !rw.Resource.IsRegistered() {
+		return fmt.Errorf("resource not registered: %s", resourceID)
+	}
+
+	if rw.Resource.IsExisting() {
+		return fmt.Errorf("resource already exists in state: %s", resourceID)
+	}
+
+	switch res := rw.Resource.(type) {
+	case ResourceImporter:
+		err := withResourceTimeout(ctx, rw, OperationImport, func(ctx context.Context) error {
+			return res.Import(ctx, meta, externalID)
+		})
+		if err != nil {
+			return fmt.Errorf("error importing %s: %w", resourceID, err)
+		}
+
+		rw.Resource.MarkAsExisting()
+	case ResourceReader:
+		if externalID != "" {
+			return fmt.Errorf("resource type does not support import by external ID, use registered fields instead: %s", resourceID.Type)
+		}
+
+		err := withResourceTimeout(ctx, rw, OperationRead, func(ctx context.Context) error {
+			return res.Read(ctx, meta)
+		})
+		if err != nil {
+			return fmt.Errorf("error importing %s: %w", resourceID, err)
+		}
+
+		if !rw.Resource.IsExisting() {
+			return fmt.Errorf("resource to import not found: %s", resourceID)
+		}
+	default:
+		return fmt.Errorf("resource type does not support import: %s", resourceID.Type)
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package registry
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MoveRule re-keys loaded resources so that renamed resources are not deleted and created again.
+// From has to match resource ID exactly unless Wildcard is set, then empty parts of From match anything.
+// Empty parts of To keep original value, e.g. to handle app rename:
+//
+//	MoveRule{From: ResourceID{Source: SourceApp, Namespace: oldAppID}, To: ResourceID{Namespace: newAppID}, Wildcard: true}
+type MoveRule struct {
+	From, To ResourceID
+	Wildcard bool
+}
+
+func matchesPart(pattern, v string, wildcard bool) bool {
+	return pattern == v || (wildcard && pattern == "")
+}
+
+func (m *MoveRule) Matches(id *ResourceID) bool {
+	return matchesPart(m.From.Source, id.Source, m.Wildcard) &&
+		matchesPart(m.From.Namespace, id.Namespace, m.Wildcard) &&
+		matchesPart(m.From.Type, id.Type, m.Wildcard) &&
+		matchesPart(m.From.ID, id.ID, m.Wildcard) &&
+		matchesPart(m.From.Partition, id.Partition, m.Wildcard)
+}
+
+func (m *MoveRule) apply(id ResourceID) ResourceID { //nolint:gocritic
+	if m.To.Source != "" {
+		id.Source = m.To.Source
+	}
+
+	if m.To.Namespace != "" {
+		id.Namespace = m.To.Namespace
+	}
+
+	if m.To.Type != "" {
+		id.Type = m.To.Type
+	}
+
+	if m.To.ID != "" {
+		id.ID = m.To.ID
+	}
+
+	if m.To.Partition != "" {
+		id.Partition = m.To.Partition
+	}
+
+	return id
+}
+
+// AddMoveRule adds rules applied to resources during Load. First matching rule is used.
+func (r *Registry) AddMoveRule(rules ...*MoveRule) {
+	r.moveRules = append(r.moveRules, rules...)
+}
+
+func isFullResourceID(id *ResourceID) bool {
+	return id.Source != "" && id.Namespace != "" && id.Type != "" && id.ID != ""
+}
+
+// Move renames resource stored in state. It has to be called before Load.
+// Both IDs have to be fully specified, use AddMoveRule with Wildcard set to move multiple resources.
+// Partition of oldID defaults to partition of registry, partition of resource is preserved unless set in newID.
+func (r *Registry) Move(oldID, newID ResourceID) error { //nolint:gocritic
+	if !isFullResourceID(&oldID) || !isFullResourceID(&newID) {
+		return errors.New("move requires fully specified source, namespace, type and id")
+	}
+
+	if oldID.Partition == "" {
+		oldID.Partition = r.partition
+	}
+
+	r.AddMoveRule(&MoveRule{From: oldID, To: newID})
+
+	return nil
+}
+
+func (r *Registry) movedID(id ResourceID) ResourceID { //nolint:gocritic
+	for _, m := range r.moveRules {
+		if m.Matches(&id) {
+			return m.apply(id)
+		}
+	}
+
+	return id
+}
+
+func (r *Registry) movedIDs(ids []ResourceID) []ResourceID {
+	for i, id := range ids {
+		ids[i] = r.movedID(id)
+	}
+
+	return ids
+}
+
+// applyMoves re-keys loaded resources together with their dependencies.
+func (r *Registry) applyMoves(loaded []*ResourceSerialized) error {
+	if len(r.moveRules) == 0 {
+		return nil
+	}
+
+	seen := make(map[ResourceID]ResourceID, len(loaded))
+
+	for _, e := range loaded {
+		oldID := e.ResourceID
+		e.ResourceID = r.movedID(oldID)
+
+		if prev, ok := seen[e.ResourceID]; ok {
+			return fmt.Errorf("cannot move resource %s to %s: already used by %s",
+				resourceIDPath(&oldID), resourceIDPath(&e.ResourceID), resourceIDPath(&prev))
+		}
+
+		seen[e.ResourceID] = oldID
+		e.Dependencies = r.movedIDs(e.Dependencies)
+		e.DependedBy = r.movedIDs(e.DependedBy)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	apiv1 "github.com/outblocks/outblocks-plugin-go/gen/api/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+var ErrPlanChanged = errors.New("plan changed")
+
+func planActionKey(a *apiv1.PlanAction) string {
+	return fmt.Sprintf("%s/%s/%s/%s", a.Source, a.Namespace, a.ObjectType, a.ObjectId)
+}
+
+// normalizePlanActions returns copies of actions mapped by their object with sorted field list.
+func normalizePlanActions(actions []*apiv1.PlanAction) map[string]*apiv1.PlanAction {
+	ret := make(map[string]*apiv1.PlanAction, len(actions))
+
+	for _, a := range actions {
+		a = proto.Clone(a).(*apiv1.PlanAction) //nolint:errcheck
+		sort.Strings(a.Fields)
+
+		ret[planActionKey(a)] = a
+	}
+
+	return ret
+}
+
+func comparePlanActions(expected, actual []*apiv1.PlanAction) error {
+	exp := normalizePlanActions(expected)
+	act := normalizePlanActions(actual)
+
+	var changes []string
+
+	for k, a := range act {
+		e, ok := exp[k]
+
+		switch {
+		case !ok:
+			changes = append(changes, fmt.Sprintf("%s: unexpected %s", k, a.Type))
+		case e.Type != a.Type:
+			changes = append(changes, fmt.Sprintf("%s: expected %s, got %s", k, e.Type, a.Type))
+		case !proto.Equal(e, a):
+			changes = append(changes, fmt.Sprintf("%s: fields changed", k))
+		}
+	}
+
+	for k, e := range exp {
+		if _, ok := act[k]; !ok {
+			changes = append(changes, fmt.Sprintf("%s: missing %s", k, e.Type))
+		}
+	}
+
+	if len(changes) == 0 {
+		return nil
+	}
+
+	sort.Strings(changes)
+
+	return fmt.Errorf("%w: %s", ErrPlanChanged, strings.Join(changes, "; "))
+}
+
+// ComparePlan returns ErrPlanChanged describing differences if diff doesn't match saved plan.
+func ComparePlan(plan *apiv1.Plan, diff []*Diff) error {
+	return comparePlanActions(plan.GetActions(), PlanActionFromDiff(diff))
+}
+
+func planScopeKey(source, namespace, id string) string {
+	return fmt.Sprintf("%s/%s/%s", source, namespace, id)
+}
+
+// comparePlan compares diff only with actions of saved plan that belong to resources of registry partition,
+// so that actions of other partitions or actions not created from registry are not reported as missing.
+func (r *Registry) comparePlan(diff []*Diff) error {
+	scope := make(map[string]struct{}, len(r.resources))
+
+	for _, rw := range r.resources {
+		if rw.Partition == r.partition {
+			scope[planScopeKey(rw.Source, rw.Namespace, rw.ID)] = struct{}{}
+		}
+	}
+
+	var expected []*apiv1.PlanAction
+
+	for _, a := range r.opts.SavedPlan.GetActions() {
+		if _, ok := scope[planScopeKey(a.Source, a.Namespace, a.ObjectId)]; ok {
+			expected = append(expected, a)
+		}
+	}
+
+	return comparePlanActions(expected, PlanActionFromDiff(diff))
+}
+
+// CompareDiff returns ErrPlanChanged describing differences if diffs are not equal.
+func CompareDiff(expected, actual []*Diff) error {
+	return comparePlanActions(PlanActionFromDiff(expected), PlanActionFromDiff(actual))
+}
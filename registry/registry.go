@@ -2,17 +2,20 @@ package registry
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
 	"sort"
 	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	apiv1 "github.com/outblocks/outblocks-plugin-go/gen/api/v1"
 	"github.com/outblocks/outblocks-plugin-go/registry/fields"
 	"github.com/outblocks/outblocks-plugin-go/util/errgroup"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -24,14 +27,49 @@ const (
 )
 
 type ResourceTypeInfo struct {
-	Type   reflect.Type
-	Fields map[string]*FieldTypeInfo
+	Type       reflect.Type
+	Fields     map[string]*FieldTypeInfo
+	Properties *ResourceProperties
+}
+
+// Concurrency limits number of resources processed in parallel in each phase. Non-positive values use default.
+type Concurrency struct {
+	Init  int
+	Read  int
+	Apply int
+}
+
+func concurrencyOrDefault(v int) int {
+	if v <= 0 {
+		return defaultConcurrency
+	}
+
+	return v
 }
 
 type Options struct {
 	Read            bool
 	Destroy         bool
 	AllowDuplicates bool
+	StateEncrypter  StateEncrypter
+	RetryPolicy     *RetryPolicy
+	Concurrency     Concurrency
+
+	// Targets limit diff and apply to matching resources and their dependencies.
+	Targets []*ResourceTarget
+
+	// CheckpointInterval limits how often checkpoint hook is called during apply.
+	CheckpointInterval time.Duration
+
+	// SavedPlan makes Apply fail with ErrPlanChanged if diff to apply differs from it.
+	// Only actions of resources of registry partition are compared, other actions are ignored.
+	SavedPlan *apiv1.Plan
+
+	// Rollback makes Apply revert already applied creates and updates on failure.
+	Rollback bool
+
+	// TracerProvider is used for spans of registry phases, global one is used if nil.
+	TracerProvider trace.TracerProvider
 }
 
 type Registry struct {
@@ -39,10 +77,16 @@ type Registry struct {
 	types         map[string]*ResourceTypeInfo
 	fieldMap      map[any]*ResourceWrapper
 	skippedAppIDs map[string]bool
+	protectedDeps map[string]bool
 	partition     string
 
+	checkpointHook func(state []byte)
+	moveRules      []*MoveRule
+	migrations     map[string][]MigrationFunc
+
 	resources       map[ResourceID]*ResourceWrapper
 	loadedResources map[ResourceID]*ResourceSerialized
+	loaded          bool
 }
 
 func NewRegistry(opts *Options) *Registry {
@@ -55,7 +99,9 @@ func NewRegistry(opts *Options) *Registry {
 		types:         make(map[string]*ResourceTypeInfo),
 		fieldMap:      make(map[any]*ResourceWrapper),
 		skippedAppIDs: make(map[string]bool),
+		protectedDeps: make(map[string]bool),
 		resources:     make(map[ResourceID]*ResourceWrapper),
+		migrations:    make(map[string][]MigrationFunc),
 	}
 }
 
@@ -88,6 +134,12 @@ func mapFieldTypeInfo(fieldsMap map[string]*FieldTypeInfo, t reflect.Type, prefi
 	}
 }
 
+// SetCheckpointHook sets function called during Apply with serialized registry state after resources are changed.
+// Calls are debounced according to CheckpointInterval option and the last one happens before Apply returns.
+func (r *Registry) SetCheckpointHook(f func(state []byte)) {
+	r.checkpointHook = f
+}
+
 func (r *Registry) Partition(p string) *Registry {
 	ret := *r
 	ret.partition = p
@@ -110,9 +162,18 @@ func (r *Registry) RegisterType(o Resource) {
 
 	mapFieldTypeInfo(fieldsMap, t, "")
 
+	var props *ResourceProperties
+
+	if ft, ok := t.FieldByName("ResourceBase"); ok && ft.Anonymous {
+		props = parseResourcePropertiesTag(ft.Tag.Get("state"))
+	} else {
+		props = &ResourceProperties{}
+	}
+
 	r.types[t.Name()] = &ResourceTypeInfo{
-		Type:   t,
-		Fields: fieldsMap,
+		Type:       t,
+		Fields:     fieldsMap,
+		Properties: props,
 	}
 }
 
@@ -162,6 +223,12 @@ func (r *Registry) SkipAppResources(app *apiv1.App) {
 	r.skippedAppIDs[app.Id] = true
 }
 
+// PreventDestroyDependencyResources makes diff fail if any resource of dependency would be deleted or recreated,
+// same as prevent_destroy tag but for all resources of dependency, e.g. when DatabaseDepOptions.PreventDestroy is set.
+func (r *Registry) PreventDestroyDependencyResources(dep *apiv1.Dependency) {
+	r.protectedDeps[dep.Id] = true
+}
+
 func (r *Registry) RegisterAppResource(app *apiv1.App, id string, o Resource) (added bool, err error) {
 	resID := r.createResourceID(SourceApp, app.Id, id, o)
 	return r.register(resID, o)
@@ -378,13 +445,44 @@ func (r *Registry) deregister(resourceID ResourceID, o Resource) error { //nolin
 }
 
 func (r *Registry) Load(state []byte) error {
+	err := r.load(state)
+	if err == nil {
+		r.loaded = true
+	}
+
+	return err
+}
+
+// Loaded returns true if state was successfully loaded with Load. Dump of registry that was not loaded
+// does not contain previously stored resources.
+func (r *Registry) Loaded() bool {
+	return r.loaded
+}
+
+func (r *Registry) load(state []byte) error {
 	if len(state) == 0 {
 		return nil
 	}
 
-	var loaded []*ResourceSerialized
+	st, err := UnmarshalState(state)
+	if err != nil {
+		return err
+	}
+
+	loaded := st.Resources
+	storedTypes := make([]string, len(loaded))
+
+	for i, e := range loaded {
+		storedTypes[i] = e.Type
+	}
+
+	// Moves are applied first so that migrations of type resource was moved to are used.
+	err = r.applyMoves(loaded)
+	if err != nil {
+		return err
+	}
 
-	err := json.Unmarshal(state, &loaded)
+	err = r.migrate(st, storedTypes)
 	if err != nil {
 		return err
 	}
@@ -536,14 +634,22 @@ func (r *Registry) Process(ctx context.Context, meta any) error {
 	}
 
 	// Init where needed.
-	err := r.init(ctx, meta)
+	initCtx, span := r.tracer().Start(ctx, "registry.init")
+	err := r.init(initCtx, meta)
+
+	endSpan(span, err)
+
 	if err != nil {
 		return err
 	}
 
 	// Read where needed.
 	if r.opts.Read {
-		err = r.read(ctx, meta)
+		readCtx, span := r.tracer().Start(ctx, "registry.read")
+		err = r.read(readCtx, meta)
+
+		endSpan(span, err)
+
 		if err != nil {
 			return err
 		}
@@ -586,9 +692,14 @@ func mergeUniqueResource(res *ResourceWrapper, resourceUniqueIDMap map[string]*R
 }
 
 func (r *Registry) init(ctx context.Context, meta any) error {
-	return r.processInOrder(ctx, defaultConcurrency, func(res *ResourceWrapper) error {
+	return r.processInOrder(ctx, concurrencyOrDefault(r.opts.Concurrency.Init), func(res *ResourceWrapper) error {
 		if rr, ok := res.Resource.(ResourceIniter); ok {
-			return rr.Init(ctx, meta, r.opts)
+			ctx, span := r.tracer().Start(ctx, "resource.init", trace.WithAttributes(resourceAttributes(res)...))
+			err := rr.Init(ctx, meta, r.opts)
+
+			endSpan(span, err)
+
+			return err
 		}
 
 		return nil
@@ -605,7 +716,7 @@ func (r *Registry) read(ctx context.Context, meta any) error {
 
 	r.checkResources(r.resources)
 
-	err := r.processInOrder(ctx, defaultConcurrency, func(res *ResourceWrapper) error {
+	err := r.processInOrder(ctx, concurrencyOrDefault(r.opts.Concurrency.Read), func(res *ResourceWrapper) error {
 		if res.IsSkipped || res.Partition != r.partition {
 			return nil
 		}
@@ -616,22 +727,25 @@ func (r *Registry) read(ctx context.Context, meta any) error {
 		}
 
 		// Merge potentially obsolete resources with newly registered.
-		mu.Lock()
-		defer mu.Unlock()
-
 		// Only merge objects that do have unique id defined.
 		if rr, ok := res.Resource.(ResourceReference); ok && rr.ReferenceID() != "" {
+			mu.Lock()
 			obsoleteID, err := mergeUniqueResource(res, resourceUniqueIDMap)
-			if err != nil {
-				return err
-			}
 
 			if obsoleteID != nil {
 				obsoleteIDs = append(obsoleteIDs, obsoleteID)
 			}
+
+			mu.Unlock()
+
+			if err != nil {
+				return err
+			}
 		}
 
-		return rr.Read(ctx, meta)
+		return withResourceTimeout(ctx, res, OperationRead, func(ctx context.Context) error {
+			return rr.Read(ctx, meta)
+		})
 	})
 	if err != nil {
 		return err
@@ -646,10 +760,15 @@ func (r *Registry) read(ctx context.Context, meta any) error {
 }
 
 func (r *Registry) processInOrder(ctx context.Context, concurrency int, f func(res *ResourceWrapper) error) error {
+	err := r.checkCycles()
+	if err != nil {
+		return err
+	}
+
 	var pool errgroup.Runner
 
 	if concurrency > 0 {
-		pool, ctx = errgroup.WithConcurrency(ctx, defaultConcurrency)
+		pool, ctx = errgroup.WithConcurrency(ctx, concurrency)
 	} else {
 		pool, ctx = errgroup.WithContext(ctx)
 	}
@@ -697,7 +816,7 @@ func (r *Registry) processInOrder(ctx context.Context, concurrency int, f func(r
 		})
 	}
 
-	err := g.Wait()
+	err = g.Wait()
 	if err != nil && !errors.Is(err, context.Canceled) {
 		return err
 	}
@@ -820,11 +939,25 @@ func (r *Registry) checkResources(resources map[ResourceID]*ResourceWrapper) {
 }
 
 func (r *Registry) Diff(ctx context.Context, meta any) ([]*Diff, error) {
+	ctx, span := r.tracer().Start(ctx, "registry.Diff")
+	diff, err := r.diff(ctx, meta)
+
+	if err == nil {
+		span.SetAttributes(attribute.Int("diff.count", len(diff)))
+	}
+
+	endSpan(span, err)
+
+	return diff, err
+}
+
+func (r *Registry) diff(ctx context.Context, meta any) ([]*Diff, error) {
 	r.checkResources(r.resources)
 
 	var mu sync.RWMutex
 
 	diffMap := make(map[*ResourceWrapper]*Diff)
+	targeted := r.targeted()
 
 	// Process actual diff.
 	err := r.processInOrder(ctx, -1, func(res *ResourceWrapper) error {
@@ -832,6 +965,10 @@ func (r *Registry) Diff(ctx context.Context, meta any) ([]*Diff, error) {
 			return nil
 		}
 
+		if _, ok := targeted[res]; targeted != nil && !ok {
+			return nil
+		}
+
 		// Add all missing resources as deletions.
 		if !res.Resource.IsRegistered() {
 			mu.Lock()
@@ -893,25 +1030,63 @@ func (r *Registry) Diff(ctx context.Context, meta any) ([]*Diff, error) {
 		res.Resource.setDiff(d)
 	}
 
+	err = r.checkPreventDestroy(diff)
+	if err != nil {
+		return nil, err
+	}
+
 	return diff, nil
 }
 
+func (r *Registry) preventsDestroy(rw *ResourceWrapper) bool {
+	if rw.Source == SourceDependency && r.protectedDeps[rw.Namespace] {
+		return true
+	}
+
+	if rdp, ok := rw.Resource.(ResourceDestroyPreventer); ok && rdp.PreventDestroy() {
+		return true
+	}
+
+	rti, ok := r.types[rw.Type]
+
+	return ok && rti.Properties.PreventDestroy
+}
+
+func (r *Registry) checkPreventDestroy(diff []*Diff) error {
+	var protected []string
+
+	for _, d := range diff {
+		if (d.Type == DiffTypeDelete || d.Type == DiffTypeRecreate) && r.preventsDestroy(d.Object) {
+			protected = append(protected, fmt.Sprintf("%s (%s)", resourceIDPath(&d.Object.ResourceID), d.Type))
+		}
+	}
+
+	if len(protected) == 0 {
+		return nil
+	}
+
+	sort.Strings(protected)
+
+	return fmt.Errorf("plan would destroy resources with prevent_destroy set: %s", strings.Join(protected, ", "))
+}
+
 func (r *Registry) Dump() ([]byte, error) {
-	var resources []*ResourceWrapper
+	var resources []*ResourceSerialized
 
 	for _, res := range r.resources {
 		if res.Resource.IsDeleted() || res.Resource.SkipState() {
 			continue
 		}
 
-		resources = append(resources, res)
-	}
+		rs, err := res.serialize(isExistingResource)
+		if err != nil {
+			return nil, err
+		}
 
-	sort.Slice(resources, func(i, j int) bool {
-		return resources[i].Less(&resources[j].ResourceID)
-	})
+		resources = append(resources, rs)
+	}
 
-	return json.Marshal(resources)
+	return r.marshalState(resources)
 }
 
 func waitContext(ctx context.Context, wg *sync.WaitGroup) error {
@@ -931,12 +1106,27 @@ func waitContext(ctx context.Context, wg *sync.WaitGroup) error {
 	}
 }
 
-func handleDiffAction(ctx context.Context, meta any, d *Diff, callback func(*apiv1.ApplyAction)) error {
+// handleDiffAction applies diff step. Resource calls use opCtx while ctx limits retries.
+func handleDiffAction(ctx, opCtx context.Context, meta any, d *Diff, policy *RetryPolicy, callback func(*apiv1.ApplyAction)) error {
+	run := func(step, total int, op Operation, f func(ctx context.Context) error) error {
+		return policy.Run(ctx, func() error {
+			return withResourceTimeout(opCtx, d.Object, op, f)
+		}, func(attempt int, err error) {
+			a := d.ToApplyAction(step, total)
+			a.RetryAttempt = int32(attempt) //nolint:gosec
+			a.RetryError = err.Error()
+
+			callback(a)
+		})
+	}
+
 	switch d.Type {
 	case DiffTypeCreate:
 		callback(d.ToApplyAction(0, 1))
 
-		err := d.Object.Resource.(ResourceCUD).Create(ctx, meta) //nolint:errcheck
+		err := run(0, 1, OperationCreate, func(ctx context.Context) error {
+			return d.Object.Resource.(ResourceCUD).Create(ctx, meta) //nolint:errcheck
+		})
 		if err != nil {
 			return err
 		}
@@ -948,7 +1138,9 @@ func handleDiffAction(ctx context.Context, meta any, d *Diff, callback func(*api
 	case DiffTypeUpdate:
 		callback(d.ToApplyAction(0, 1))
 
-		err := d.Object.Resource.(ResourceCUD).Update(ctx, meta) //nolint:errcheck
+		err := run(0, 1, OperationUpdate, func(ctx context.Context) error {
+			return d.Object.Resource.(ResourceCUD).Update(ctx, meta) //nolint:errcheck
+		})
 		if err != nil {
 			return err
 		}
@@ -959,7 +1151,9 @@ func handleDiffAction(ctx context.Context, meta any, d *Diff, callback func(*api
 	case DiffTypeProcess:
 		callback(d.ToApplyAction(0, 1))
 
-		err := d.Object.Resource.(ResourceProcessor).Process(ctx, meta) //nolint:errcheck
+		err := run(0, 1, OperationProcess, func(ctx context.Context) error {
+			return d.Object.Resource.(ResourceProcessor).Process(ctx, meta) //nolint:errcheck
+		})
 		if err != nil {
 			return err
 		}
@@ -970,7 +1164,9 @@ func handleDiffAction(ctx context.Context, meta any, d *Diff, callback func(*api
 	case DiffTypeDelete:
 		callback(d.ToApplyAction(0, 1))
 
-		err := d.Object.Resource.(ResourceCUD).Delete(ctx, meta) //nolint:errcheck
+		err := run(0, 1, OperationDelete, func(ctx context.Context) error {
+			return d.Object.Resource.(ResourceCUD).Delete(ctx, meta) //nolint:errcheck
+		})
 		if err != nil {
 			return err
 		}
@@ -982,7 +1178,9 @@ func handleDiffAction(ctx context.Context, meta any, d *Diff, callback func(*api
 		if d.AppliedSteps() == 0 {
 			callback(d.ToApplyAction(0, 2))
 
-			err := d.Object.Resource.(ResourceCUD).Delete(ctx, meta) //nolint:errcheck
+			err := run(0, 2, OperationDelete, func(ctx context.Context) error {
+				return d.Object.Resource.(ResourceCUD).Delete(ctx, meta) //nolint:errcheck
+			})
 			if err != nil {
 				return err
 			}
@@ -991,7 +1189,9 @@ func handleDiffAction(ctx context.Context, meta any, d *Diff, callback func(*api
 			d.Object.Resource.SetState(ResourceStateDeleted)
 			callback(d.ToApplyAction(1, 2))
 		} else {
-			err := d.Object.Resource.(ResourceCUD).Create(ctx, meta) //nolint:errcheck
+			err := run(1, 2, OperationCreate, func(ctx context.Context) error {
+				return d.Object.Resource.(ResourceCUD).Create(ctx, meta) //nolint:errcheck
+			})
 			if err != nil {
 				return err
 			}
@@ -1010,6 +1210,14 @@ func handleDiffAction(ctx context.Context, meta any, d *Diff, callback func(*api
 	return nil
 }
 
+func (r *Registry) retryPolicy(d *Diff) *RetryPolicy {
+	if rr, ok := d.Object.Resource.(ResourceRetrier); ok {
+		return rr.RetryPolicy()
+	}
+
+	return r.opts.RetryPolicy
+}
+
 func waitForDiffDeps(ctx context.Context, d *Diff, step int) error {
 	// During creation/update/process - wait for all dependencies to finish on create/update/process.
 	if d.Type == DiffTypeCreate || d.Type == DiffTypeUpdate || d.Type == DiffTypeProcess || (d.Type == DiffTypeRecreate && step == 1) {
@@ -1044,21 +1252,90 @@ func waitForDiffDeps(ctx context.Context, d *Diff, step int) error {
 	return nil
 }
 
+// ErrApplyCancelled is a cause of context cancellation (see context.WithCancelCause) that makes Apply
+// stop scheduling new changes while letting in-flight ones finish, applied changes are not rolled back.
+var ErrApplyCancelled = errors.New("apply cancelled")
+
+func isApplyCancelled(ctx context.Context) bool {
+	return errors.Is(context.Cause(ctx), ErrApplyCancelled)
+}
+
 func (r *Registry) Apply(ctx context.Context, meta any, diff []*Diff, callback func(*apiv1.ApplyAction)) error {
-	pool, ctx := errgroup.WithConcurrency(ctx, defaultConcurrency)
+	ctx, span := r.tracer().Start(ctx, "registry.Apply")
+	err := r.apply(ctx, meta, diff, callback)
+
+	endSpan(span, err)
+
+	return err
+}
+
+func (r *Registry) apply(ctx context.Context, meta any, diff []*Diff, callback func(*apiv1.ApplyAction)) (err error) {
+	if targeted := r.targeted(); targeted != nil {
+		var filtered []*Diff
+
+		for _, d := range diff {
+			if _, ok := targeted[d.Object]; ok {
+				filtered = append(filtered, d)
+			}
+		}
+
+		diff = filtered
+	}
+
+	if r.opts.SavedPlan != nil {
+		err := r.comparePlan(diff)
+		if err != nil {
+			return err
+		}
+	}
 
 	for _, d := range diff {
 		d.Object.Resource.setDiff(d)
 	}
 
+	var snapshot rollbackSnapshot
+
+	if r.opts.Rollback {
+		snapshot = newRollbackSnapshot(diff)
+	}
+
+	parentCtx := ctx
+	pool, ctx := errgroup.WithConcurrency(ctx, concurrencyOrDefault(r.opts.Concurrency.Apply))
+
 	// Add another cancel for errgroup context so that we can handle error from pool at all times.
 	ctxErrgroup, cancelErrgroup := context.WithCancel(ctx)
 
 	defer cancelErrgroup()
 
+	// Cancellation of ctx with ErrApplyCancelled cause stops scheduling of new changes but in-flight resource calls
+	// are drained so that their result is not lost. Resource calls are cancelled on apply error and on any other
+	// cancellation of ctx, e.g. when host connection is lost, deadline of ctx is kept.
+	opCtx, cancelOps := context.WithCancel(context.WithoutCancel(parentCtx))
+
+	defer cancelOps()
+
+	if deadline, ok := parentCtx.Deadline(); ok {
+		var cancelDeadline context.CancelFunc
+
+		opCtx, cancelDeadline = context.WithDeadline(opCtx, deadline)
+
+		defer cancelDeadline()
+	}
+
+	stopOps := context.AfterFunc(parentCtx, func() {
+		if !isApplyCancelled(parentCtx) {
+			cancelOps()
+		}
+	})
+
+	defer stopOps()
+
 	g, _ := errgroup.WithContext(ctxErrgroup)
 
-	var mu sync.Mutex
+	var (
+		mu sync.Mutex
+		cp *checkpointer
+	)
 
 	cb := func(a *apiv1.ApplyAction) {
 		if callback != nil {
@@ -1068,6 +1345,17 @@ func (r *Registry) Apply(ctx context.Context, meta any, diff []*Diff, callback f
 		}
 	}
 
+	// Checkpoint hook shares lock with callback as both usually send to the same stream.
+	if r.checkpointHook != nil {
+		cp = newCheckpointer(r.resources, r.checkpointHook, r.marshalState, r.opts.CheckpointInterval, &mu)
+
+		defer func() {
+			if cpErr := cp.Close(); cpErr != nil {
+				err = errors.Join(err, cpErr)
+			}
+		}()
+	}
+
 	for _, d := range diff {
 		for step := range d.RequiredSteps() {
 			g.Go(func() error {
@@ -1083,15 +1371,24 @@ func (r *Registry) Apply(ctx context.Context, meta any, diff []*Diff, callback f
 				}
 
 				pool.Go(func() error {
-					err := handleDiffAction(ctx, meta, d, cb)
+					if err := ctx.Err(); err != nil {
+						return err
+					}
+
+					err := handleDiffAction(ctx, opCtx, meta, d, r.retryPolicy(d), cb)
 					if err != nil {
 						cancelErrgroup()
+						cancelOps()
 
 						return fmt.Errorf("applying changes to %s '%s' error: %w", d.ObjectType(), d.Object.Resource.GetName(), err)
 					}
 
 					d.MarkStepAsApplied()
 
+					if cp != nil {
+						cp.Update(d.Object)
+					}
+
 					return nil
 				})
 
@@ -1100,12 +1397,47 @@ func (r *Registry) Apply(ctx context.Context, meta any, diff []*Diff, callback f
 		}
 	}
 
-	err := g.Wait()
-	if err != nil && !errors.Is(err, context.Canceled) {
-		return err
+	panicErr, err := waitRecover(g)
+
+	switch {
+	case panicErr != nil:
+		cancelErrgroup()
+
+		_, _ = waitRecover(pool)
+	case err != nil && !errors.Is(err, context.Canceled):
+		if !r.opts.Rollback {
+			return err
+		}
+
+		// Wait for in-flight changes before rolling back.
+		panicErr, _ = waitRecover(pool)
+	default:
+		scheduleErr := err
+
+		// Keep cancellation error if changes were not scheduled because apply was cancelled.
+		panicErr, err = waitRecover(pool)
+		if err == nil {
+			err = scheduleErr
+		}
+	}
+
+	if panicErr != nil {
+		err = panicErr
 	}
 
-	err = pool.Wait()
+	// Changes applied before apply was cancelled with ErrApplyCancelled are kept.
+	if err != nil && r.opts.Rollback && !isApplyCancelled(parentCtx) {
+		// Rollback even if apply failed because of lost host connection or panicked.
+		rbErr := r.rollback(context.WithoutCancel(parentCtx), meta, diff, snapshot, cb, cp)
+		if rbErr != nil {
+			err = errors.Join(err, rbErr)
+		}
+	}
+
+	// Propagate panic after rollback so that it is handled by caller as before.
+	if panicErr != nil {
+		panic(panicErr)
+	}
 
 	// Cleanup resources.
 	res := make(map[ResourceID]*ResourceWrapper, len(r.resources))
@@ -1121,7 +1453,63 @@ func (r *Registry) Apply(ctx context.Context, meta any, diff []*Diff, callback f
 	return err
 }
 
+// resetIgnoredChanges sets wanted value of ignore_changes fields of existing resource back to current one
+// so that ignored changes are neither applied by Update nor stored in state.
+// Fields that get their value from other fields are skipped as their wanted value cannot be set.
+func resetIgnoredChanges(rw *ResourceWrapper) error {
+	if !rw.Resource.IsExisting() {
+		return nil
+	}
+
+	for name, f := range rw.Fields {
+		if f.Type.Properties.Ignored || !f.Type.Properties.IgnoreChanges || f.Value.IsNil() {
+			continue
+		}
+
+		if _, ok := f.Value.Interface().(fields.FieldDependencyHolder); ok {
+			continue
+		}
+
+		if _, ok := f.Value.Interface().(fields.InputField); !ok {
+			continue
+		}
+
+		cur, ok := fields.LookupCurrentValue(f.Value.Interface())
+		if !ok {
+			continue
+		}
+
+		err := fields.SetWantedValue(f.Value.Interface(), cur)
+		if err != nil {
+			return fmt.Errorf("error resetting ignored field %s of %s: %w", name, rw, err)
+		}
+	}
+
+	return nil
+}
+
+// waitRecover waits for group and returns panic propagated by its Wait instead of panicking.
+func waitRecover(g errgroup.Runner) (panicErr *errgroup.PanicError, err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			pe, ok := v.(*errgroup.PanicError)
+			if !ok {
+				panic(v)
+			}
+
+			panicErr = pe
+		}
+	}()
+
+	return nil, g.Wait()
+}
+
 func (r *Registry) calculateDiff(ctx context.Context, rw *ResourceWrapper, meta any) (*Diff, error) {
+	err := resetIgnoredChanges(rw)
+	if err != nil {
+		return nil, err
+	}
+
 	if rdc, ok := rw.Resource.(ResourceDiffCalculator); ok {
 		typ, err := rdc.CalculateDiff(ctx, meta)
 		if err != nil {
@@ -1129,7 +1517,10 @@ func (r *Registry) calculateDiff(ctx context.Context, rw *ResourceWrapper, meta
 		}
 
 		if typ != DiffTypeNone {
-			return NewDiff(rw, typ, rw.FieldList()), nil
+			d := NewDiff(rw, typ, rw.FieldList())
+			d.FieldChanges = newFieldChanges(rw, rw.FieldList())
+
+			return d, nil
 		}
 
 		return nil, nil
@@ -1145,20 +1536,27 @@ func (r *Registry) calculateDiff(ctx context.Context, rw *ResourceWrapper, meta
 	if rw.Resource.IsNew() {
 		typ := DiffTypeCreate
 
-		return NewDiff(rw, typ, rw.FieldList()), nil
+		d := NewDiff(rw, typ, rw.FieldList())
+		d.FieldChanges = newFieldChanges(rw, rw.FieldList())
+
+		return d, nil
 	}
 
 	forceNew := false
 
-	var fieldsList []string
+	var (
+		fieldsList   []string
+		fieldChanges []*FieldChange
+	)
 
 	for name, f := range rw.Fields {
-		fieldChanged, fieldForceNew := r.calculateFieldDiff(rw, f)
+		fieldChanged, fieldForceNew, fieldUnknown := r.calculateFieldDiff(rw, f)
 		if !fieldChanged {
 			continue
 		}
 
 		fieldsList = append(fieldsList, name)
+		fieldChanges = append(fieldChanges, newFieldChange(rw, name, f, fieldForceNew, fieldUnknown))
 
 		if fieldForceNew {
 			forceNew = true
@@ -1174,12 +1572,17 @@ func (r *Registry) calculateDiff(ctx context.Context, rw *ResourceWrapper, meta
 		typ = DiffTypeRecreate
 	}
 
-	return NewDiff(rw, typ, fieldsList), nil
+	sortFieldChanges(fieldChanges)
+
+	d := NewDiff(rw, typ, fieldsList)
+	d.FieldChanges = fieldChanges
+
+	return d, nil
 }
 
-func (r *Registry) calculateFieldDiff(rw *ResourceWrapper, field *FieldInfo) (changed, forceNew bool) {
-	if field.Type.Properties.Ignored {
-		return false, false
+func (r *Registry) calculateFieldDiff(rw *ResourceWrapper, field *FieldInfo) (changed, forceNew, unknown bool) {
+	if field.Type.Properties.Ignored || (field.Type.Properties.IgnoreChanges && rw.Resource.IsExisting()) {
+		return false, false, false
 	}
 
 	v := field.Value.Interface().(fields.ValueTracker) //nolint:errcheck
@@ -1193,16 +1596,16 @@ func (r *Registry) calculateFieldDiff(rw *ResourceWrapper, field *FieldInfo) (ch
 			}
 
 			if field.Type.Properties.HardLink && rw.Resource.IsExisting() && dep.Resource.Diff().Type == DiffTypeCreate {
-				return true, false
+				return true, false, true
 			}
 
 			if dep.Resource.Diff().Type == DiffTypeRecreate || (dep.Resource.IsExisting() && field.Value.Interface().(fields.Field).IsOutput()) { //nolint:errcheck
-				return true, field.Type.Properties.ForceNew || field.Type.Properties.HardLink
+				return true, field.Type.Properties.ForceNew || field.Type.Properties.HardLink, true
 			}
 		}
 	}
 
-	return v.IsChanged(), field.Type.Properties.ForceNew
+	return v.IsChanged(), field.Type.Properties.ForceNew, false
 }
 
 func (r *Registry) ProcessAndDiff(ctx context.Context, meta any) ([]*Diff, error) {
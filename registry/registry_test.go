@@ -0,0 +1,1630 @@
+package registry_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	apiv1 "github.com/outblocks/outblocks-plugin-go/gen/api/v1"
+
+	"github.com/outblocks/outblocks-plugin-go/registry"
+	"github.com/outblocks/outblocks-plugin-go/registry/fields"
+	"github.com/outblocks/outblocks-plugin-go/types"
+	"github.com/outblocks/outblocks-plugin-go/util/errgroup"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type testResource struct {
+	registry.ResourceBase
+
+	Name     fields.StringInputField
+	Password fields.StringInputField `state:"sensitive"`
+}
+
+func (o *testResource) GetName() string {
+	return o.Name.Any()
+}
+
+func (o *testResource) Create(ctx context.Context, meta any) error {
+	return nil
+}
+
+func (o *testResource) Update(ctx context.Context, meta any) error {
+	return nil
+}
+
+func (o *testResource) Delete(ctx context.Context, meta any) error {
+	return nil
+}
+
+func (o *testResource) Import(ctx context.Context, meta any, externalID string) error {
+	o.Name.SetCurrent(externalID)
+
+	return nil
+}
+
+func applyAll(t *testing.T, reg *registry.Registry) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	diff, err := reg.ProcessAndDiff(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = reg.Apply(ctx, nil, diff, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDebugStringMasksProxiedFields(t *testing.T) {
+	reg := registry.NewRegistry(nil)
+	res := &testResource{Name: fields.String("db"), Password: fields.String("hunter2")}
+	proxied := &testResource{Name: res.Password}
+
+	_, err := reg.RegisterPluginResource("test", "db", res)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = reg.RegisterPluginResource("test", "proxied", proxied)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	applyAll(t, reg)
+
+	if s := proxied.Wrapper().DebugString(); strings.Contains(s, "hunter2") || !strings.Contains(s, "Name="+registry.SensitiveValueMask) {
+		t.Fatalf("proxied sensitive value not masked: %s", s)
+	}
+}
+
+func TestSensitiveFieldEncryption(t *testing.T) {
+	enc, err := registry.NewAESStateEncrypter(bytes.Repeat([]byte{1}, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reg := registry.NewRegistry(&registry.Options{StateEncrypter: enc})
+	res := &testResource{Name: fields.String("db"), Password: fields.String("hunter2")}
+
+	_, err = reg.RegisterPluginResource("test", "db", res)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s := res.Wrapper().DebugString(); strings.Contains(s, "hunter2") || !strings.Contains(s, "Password="+registry.SensitiveValueMask) {
+		t.Fatalf("sensitive value not masked: %s", s)
+	}
+
+	if s := res.Wrapper().String(); strings.Contains(s, "Fields") {
+		t.Fatalf("expected String to only describe resource ID: %s", s)
+	}
+
+	applyAll(t, reg)
+
+	state, err := reg.Dump()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if bytes.Contains(state, []byte("hunter2")) {
+		t.Fatalf("sensitive value stored in plaintext: %s", state)
+	}
+
+	reg = registry.NewRegistry(&registry.Options{StateEncrypter: enc})
+	res = &testResource{Name: fields.String("db"), Password: fields.String("hunter2")}
+
+	_, err = reg.RegisterPluginResource("test", "db", res)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = reg.Load(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if res.Password.Current() != "hunter2" {
+		t.Fatalf("expected decrypted password, got: %q", res.Password.Current())
+	}
+
+	reg = registry.NewRegistry(nil)
+
+	_, err = reg.RegisterPluginResource("test", "db", &testResource{Name: fields.String("db"), Password: fields.String("hunter2")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = reg.Load(state)
+	if err == nil || !strings.Contains(err.Error(), "no state encrypter") {
+		t.Fatalf("expected missing encrypter error, got: %v", err)
+	}
+}
+
+var errTransient = errors.New("transient error")
+
+type flakyResource struct {
+	registry.ResourceBase
+
+	Dep fields.StringInputField
+
+	failures int
+	panic    bool
+}
+
+func (o *flakyResource) GetName() string {
+	return "flaky"
+}
+
+func (o *flakyResource) Update(ctx context.Context, meta any) error {
+	return nil
+}
+
+func (o *flakyResource) Delete(ctx context.Context, meta any) error {
+	return nil
+}
+
+func (o *flakyResource) Create(ctx context.Context, meta any) error {
+	if o.panic {
+		panic("create failed")
+	}
+
+	if o.failures > 0 {
+		o.failures--
+
+		return errTransient
+	}
+
+	return nil
+}
+
+func (o *flakyResource) RetryPolicy() *registry.RetryPolicy {
+	return &registry.RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		Retryable: func(err error) bool {
+			return errors.Is(err, errTransient)
+		},
+	}
+}
+
+func TestApplyRetry(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		failures int
+		fail     bool
+	}{
+		{failures: 2},
+		{failures: 3, fail: true},
+	} {
+		reg := registry.NewRegistry(nil)
+
+		_, err := reg.RegisterPluginResource("test", "flaky", &flakyResource{failures: tc.failures})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		diff, err := reg.ProcessAndDiff(ctx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var retries []int32
+
+		err = reg.Apply(ctx, nil, diff, func(a *apiv1.ApplyAction) {
+			if a.RetryAttempt > 0 {
+				retries = append(retries, a.RetryAttempt)
+			}
+		})
+
+		if tc.fail != (err != nil) {
+			t.Fatalf("failures=%d: unexpected apply result: %v", tc.failures, err)
+		}
+
+		if len(retries) != 2 || retries[0] != 2 || retries[1] != 3 {
+			t.Fatalf("failures=%d: unexpected retries reported: %v", tc.failures, retries)
+		}
+	}
+}
+
+func TestApplyCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	reg := registry.NewRegistry(nil)
+
+	_, err := reg.RegisterPluginResource("test", "ok", &testResource{Name: fields.String("ok"), Password: fields.String("pass")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = reg.RegisterPluginResource("test", "failing", &flakyResource{failures: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var checkpoints [][]byte
+
+	reg.SetCheckpointHook(func(state []byte) {
+		checkpoints = append(checkpoints, state)
+	})
+
+	diff, err := reg.ProcessAndDiff(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = reg.Apply(ctx, nil, diff, nil)
+	if !errors.Is(err, errTransient) {
+		t.Fatalf("expected apply error, got: %v", err)
+	}
+
+	if len(checkpoints) == 0 {
+		t.Fatal("expected at least one checkpoint")
+	}
+
+	state, err := registry.UnmarshalState(checkpoints[len(checkpoints)-1])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created := make(map[string]bool)
+
+	for _, rs := range state.Resources {
+		created[rs.ID] = !rs.IsNew
+	}
+
+	if !created["ok"] || created["failing"] {
+		t.Fatalf("unexpected checkpoint state: %s", checkpoints[len(checkpoints)-1])
+	}
+}
+
+type failingEncrypter struct{}
+
+var errEncrypt = errors.New("encrypt failed")
+
+func (failingEncrypter) Encrypt([]byte) ([]byte, error) { return nil, errEncrypt }
+func (failingEncrypter) Decrypt([]byte) ([]byte, error) { return nil, errEncrypt }
+
+func TestApplyCheckpointError(t *testing.T) {
+	ctx := context.Background()
+	reg := registry.NewRegistry(&registry.Options{StateEncrypter: failingEncrypter{}})
+
+	_, err := reg.RegisterPluginResource("test", "db", &testResource{Name: fields.String("db"), Password: fields.String("hunter2")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var checkpoints [][]byte
+
+	reg.SetCheckpointHook(func(state []byte) {
+		checkpoints = append(checkpoints, state)
+	})
+
+	diff, err := reg.ProcessAndDiff(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = reg.Apply(ctx, nil, diff, nil)
+	if !errors.Is(err, errEncrypt) {
+		t.Fatalf("expected checkpoint error, got: %v", err)
+	}
+
+	for _, c := range checkpoints {
+		if bytes.Contains(c, []byte(`"db"`)) {
+			t.Fatalf("expected resource that failed to serialize to be dropped from checkpoint: %s", c)
+		}
+	}
+}
+
+func TestDiffTargets(t *testing.T) {
+	target, err := registry.NewResourceTarget("", "", "", "b*")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reg := registry.NewRegistry(&registry.Options{Targets: []*registry.ResourceTarget{target}})
+	a := &testResource{Name: fields.String("a")}
+	b := &testResource{Name: a.Name}
+	c := &testResource{Name: fields.String("c")}
+
+	for _, r := range []struct {
+		id  string
+		res *testResource
+	}{{"a", a}, {"b", b}, {"c", c}} {
+		_, err = reg.RegisterPluginResource("test", r.id, r.res)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	diff, err := reg.ProcessAndDiff(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ids := make(map[string]bool)
+
+	for _, d := range diff {
+		ids[d.Object.ID] = true
+	}
+
+	if len(ids) != 2 || !ids["a"] || !ids["b"] {
+		t.Fatalf("expected diff for target and its dependency only, got: %v", ids)
+	}
+}
+
+func TestApplyTargetDeletedWithDependents(t *testing.T) {
+	target, err := registry.NewResourceTarget("", "", "", "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reg := registry.NewRegistry(&registry.Options{Targets: []*registry.ResourceTarget{target}})
+	reg.RegisterType(&testResource{})
+
+	// Both resources were removed, b depends on a.
+	state := `[
+		{"source": "plugin", "namespace": "test", "type": "testResource", "id": "a"},
+		{"source": "plugin", "namespace": "test", "type": "testResource", "id": "b",
+		 "dependencies": [{"source": "plugin", "namespace": "test", "type": "testResource", "id": "a"}]}
+	]`
+
+	err = reg.Load([]byte(state))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	diff, err := reg.ProcessAndDiff(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = reg.Apply(ctx, nil, diff, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ids := make(map[string]bool)
+
+	for _, d := range diff {
+		if d.Type == registry.DiffTypeDelete {
+			ids[d.Object.ID] = true
+		}
+	}
+
+	if len(ids) != 2 || !ids["a"] || !ids["b"] {
+		t.Fatalf("expected target to be deleted with its dependent, got: %v", ids)
+	}
+}
+
+func TestImport(t *testing.T) {
+	ctx := context.Background()
+	reg := registry.NewRegistry(nil)
+
+	_, err := reg.RegisterPluginResource("test", "db", &testResource{Name: fields.String("db")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = reg.Process(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	id := registry.ResourceIDFromProto(&apiv1.ResourceID{Source: "plugin", Namespace: "test", Type: "testResource", Id: "db"})
+
+	err = reg.Import(ctx, nil, id, "external-db")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = reg.Import(ctx, nil, id, "external-db")
+	if err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Fatalf("expected already exists error, got: %v", err)
+	}
+
+	state, err := reg.Dump()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	st, err := registry.UnmarshalState(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loaded := st.Resources
+
+	if len(loaded) != 1 || loaded[0].IsNew || loaded[0].Properties["Name"] != "external-db" {
+		t.Fatalf("unexpected imported state: %s", state)
+	}
+}
+
+type cloudResource struct {
+	registry.ResourceBase
+
+	Name fields.StringInputField
+
+	cloud map[string]string
+}
+
+func (o *cloudResource) GetName() string {
+	return o.Name.Any()
+}
+
+func (o *cloudResource) Read(ctx context.Context, meta any) error {
+	v, ok := o.cloud[o.Name.Wanted()]
+	if !ok {
+		o.MarkAsNew()
+		o.Name.UnsetCurrent()
+
+		return nil
+	}
+
+	o.MarkAsExisting()
+	o.Name.SetCurrent(v)
+
+	return nil
+}
+
+func (o *cloudResource) Create(ctx context.Context, meta any) error {
+	return nil
+}
+
+func (o *cloudResource) Update(ctx context.Context, meta any) error {
+	return nil
+}
+
+func (o *cloudResource) Delete(ctx context.Context, meta any) error {
+	return nil
+}
+
+func TestDrift(t *testing.T) {
+	ctx := context.Background()
+	cloud := map[string]string{"a": "a", "b": "b"}
+
+	register := func(reg *registry.Registry) {
+		t.Helper()
+
+		for _, id := range []string{"a", "b"} {
+			_, err := reg.RegisterPluginResource("test", id, &cloudResource{Name: fields.String(id), cloud: cloud})
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	reg := registry.NewRegistry(nil)
+	register(reg)
+	applyAll(t, reg)
+
+	state, err := reg.Dump()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cloud["a"] = "changed"
+	delete(cloud, "b")
+
+	reg = registry.NewRegistry(nil)
+	register(reg)
+
+	err = reg.Load(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	drift, err := reg.Drift(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(drift) != 2 {
+		t.Fatalf("expected 2 drifted resources, got: %d", len(drift))
+	}
+
+	if d := drift[0]; d.Object.ID != "a" || d.Missing || len(d.FieldChanges) != 1 || d.FieldChanges[0].Old != "a" || d.FieldChanges[0].New != "changed" {
+		t.Fatalf("unexpected drift of a: %+v", d)
+	}
+
+	if d := drift[1]; d.Object.ID != "b" || !d.Missing {
+		t.Fatalf("expected b to be missing: %+v", d)
+	}
+}
+
+func TestImportWithRead(t *testing.T) {
+	ctx := context.Background()
+	reg := registry.NewRegistry(nil)
+
+	_, err := reg.RegisterPluginResource("test", "db", &cloudResource{Name: fields.String("db"), cloud: map[string]string{"db": "db"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = reg.Process(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	id := registry.ResourceIDFromProto(&apiv1.ResourceID{Source: "plugin", Namespace: "test", Type: "cloudResource", Id: "db"})
+
+	err = reg.Import(ctx, nil, id, "external-db")
+	if err == nil || !strings.Contains(err.Error(), "external ID") {
+		t.Fatalf("expected external ID error, got: %v", err)
+	}
+
+	err = reg.Import(ctx, nil, id, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGraph(t *testing.T) {
+	reg := registry.NewRegistry(nil)
+	a := &testResource{Name: fields.String("a")}
+	b := &testResource{Name: fields.String("b"), Password: a.Name}
+
+	for _, res := range []*testResource{a, b} {
+		_, err := reg.RegisterPluginResource("test", res.GetName(), res)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, err := reg.ProcessAndDiff(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g := reg.Graph()
+
+	if len(g.Nodes) != 2 || g.Nodes[0].Diff != "create" {
+		t.Fatalf("unexpected nodes: %+v", g.Nodes)
+	}
+
+	if len(g.Edges) != 1 || g.Edges[0].From.ID != "b" || g.Edges[0].To.ID != "a" || len(g.Edges[0].Fields) != 1 || g.Edges[0].Fields[0] != "Password" {
+		t.Fatalf("unexpected edges: %+v", g.Edges)
+	}
+
+	var buf bytes.Buffer
+
+	err = g.WriteDOT(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(buf.String(), `"plugin/test/testResource/b" -> "plugin/test/testResource/a" [label="Password"]`) {
+		t.Fatalf("unexpected dot output: %s", buf.String())
+	}
+}
+
+func TestDependencyCycle(t *testing.T) {
+	reg := registry.NewRegistry(nil)
+	reg.RegisterType(&testResource{})
+
+	state := `[
+		{"source": "plugin", "namespace": "test", "type": "testResource", "id": "a",
+		 "dependencies": [{"source": "plugin", "namespace": "test", "type": "testResource", "id": "b"}]},
+		{"source": "plugin", "namespace": "test", "type": "testResource", "id": "b",
+		 "dependencies": [{"source": "plugin", "namespace": "test", "type": "testResource", "id": "a"}]}
+	]`
+
+	err := reg.Load([]byte(state))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = reg.ProcessAndDiff(context.Background(), nil)
+
+	var cycleErr *registry.DependencyCycleError
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf("expected dependency cycle error, got: %v", err)
+	}
+
+	expected := "dependency cycle detected: plugin/test/testResource/a -> plugin/test/testResource/b -> plugin/test/testResource/a"
+	if err.Error() != expected {
+		t.Fatalf("unexpected error message: %s", err)
+	}
+}
+
+func TestDependencyCycleFromFields(t *testing.T) {
+	reg := registry.NewRegistry(nil)
+	reg.RegisterType(&testResource{})
+
+	// Fields can only depend on already registered ones, so other direction of cycle comes from loaded state.
+	state := `[
+		{"source": "plugin", "namespace": "test", "type": "testResource", "id": "a",
+		 "dependencies": [{"source": "plugin", "namespace": "test", "type": "testResource", "id": "b"}]},
+		{"source": "plugin", "namespace": "test", "type": "testResource", "id": "b"}
+	]`
+
+	err := reg.Load([]byte(state))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := &testResource{Name: fields.String("a")}
+	b := &testResource{Name: fields.String("b"), Password: a.Name}
+
+	_, err = reg.RegisterPluginResource("test", "a", a)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = reg.RegisterPluginResource("test", "b", b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = reg.ProcessAndDiff(context.Background(), nil)
+
+	var cycleErr *registry.DependencyCycleError
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf("expected dependency cycle error, got: %v", err)
+	}
+
+	if len(cycleErr.Edges) != 2 || len(cycleErr.Edges[0].Fields) != 0 {
+		t.Fatalf("unexpected cycle edges: %s", err)
+	}
+
+	if edge := cycleErr.Edges[1]; edge.From.ID != "b" || edge.To.ID != "a" || len(edge.Fields) != 1 || edge.Fields[0] != "Password" {
+		t.Fatalf("expected cycle through Password field of b, got: %s", err)
+	}
+
+	expected := "dependency cycle detected: plugin/test/testResource/a -> plugin/test/testResource/b -(Password)-> plugin/test/testResource/a"
+	if err.Error() != expected {
+		t.Fatalf("unexpected error message: %s", err)
+	}
+}
+
+type protectedResource struct {
+	registry.ResourceBase `state:"prevent_destroy"`
+
+	Name fields.StringInputField `state:"force_new"`
+	Tier fields.StringInputField `state:"ignore_changes"`
+}
+
+func (o *protectedResource) GetName() string {
+	return o.Name.Any()
+}
+
+func (o *protectedResource) Create(ctx context.Context, meta any) error {
+	return nil
+}
+
+func (o *protectedResource) Update(ctx context.Context, meta any) error {
+	return nil
+}
+
+func (o *protectedResource) Delete(ctx context.Context, meta any) error {
+	return nil
+}
+
+func TestLifecycle(t *testing.T) {
+	ctx := context.Background()
+
+	reg := registry.NewRegistry(nil)
+
+	_, err := reg.RegisterPluginResource("test", "db", &protectedResource{Name: fields.String("db"), Tier: fields.String("small")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	applyAll(t, reg)
+
+	state, err := reg.Dump()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name, tier string
+		destroy    bool
+		err        bool
+	}{
+		{name: "db", tier: "large"},
+		{name: "db2", tier: "small", err: true},
+		{name: "db", tier: "small", destroy: true, err: true},
+	} {
+		reg = registry.NewRegistry(&registry.Options{Destroy: tc.destroy})
+		res := &protectedResource{Name: fields.String(tc.name), Tier: fields.String(tc.tier)}
+
+		_, err = reg.RegisterPluginResource("test", "db", res)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = reg.Load(state)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		diff, err := reg.ProcessAndDiff(ctx, nil)
+
+		if tc.err {
+			if err == nil || !strings.Contains(err.Error(), "prevent_destroy") {
+				t.Fatalf("%+v: expected prevent_destroy error, got: %v", tc, err)
+			}
+
+			continue
+		}
+
+		if err != nil || len(diff) != 0 {
+			t.Fatalf("%+v: expected no changes, got: %v %v", tc, diff, err)
+		}
+
+		// Ignored change must not be applied by Update nor stored in state.
+		if res.Tier.Wanted() != "small" {
+			t.Fatalf("%+v: expected ignored field to be reset to current value, got: %q", tc, res.Tier.Wanted())
+		}
+	}
+}
+
+func TestPreventDestroyDependencyResources(t *testing.T) {
+	ctx := context.Background()
+	dep := &apiv1.Dependency{Id: "dep_db"}
+
+	reg := registry.NewRegistry(nil)
+
+	_, err := reg.RegisterDependencyResource(dep, "db", &testResource{Name: fields.String("db")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	applyAll(t, reg)
+
+	state, err := reg.Dump()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reg = registry.NewRegistry(&registry.Options{Destroy: true})
+	reg.PreventDestroyDependencyResources(dep)
+
+	_, err = reg.RegisterDependencyResource(dep, "db", &testResource{Name: fields.String("db")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = reg.Load(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = reg.ProcessAndDiff(ctx, nil)
+	if err == nil || !strings.Contains(err.Error(), "prevent_destroy") {
+		t.Fatalf("expected prevent_destroy error, got: %v", err)
+	}
+}
+
+func TestMove(t *testing.T) {
+	register := func(reg *registry.Registry, aID string) {
+		t.Helper()
+
+		a := &testResource{Name: fields.String("a")}
+		b := &testResource{Name: fields.String("b"), Password: a.Name}
+
+		_, err := reg.RegisterPluginResource("test", aID, a)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = reg.RegisterPluginResource("test", "b", b)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	reg := registry.NewRegistry(nil)
+	register(reg, "a")
+	applyAll(t, reg)
+
+	state, err := reg.Dump()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reg = registry.NewRegistry(nil)
+	register(reg, "renamed")
+
+	err = reg.Move(
+		registry.ResourceID{Source: registry.SourcePlugin, Namespace: "test", ID: "a"},
+		registry.ResourceID{Source: registry.SourcePlugin, Namespace: "test", Type: "testResource", ID: "renamed"},
+	)
+	if err == nil {
+		t.Fatal("expected error when moving partially specified resource ID")
+	}
+
+	err = reg.Move(
+		registry.ResourceID{Source: registry.SourcePlugin, Namespace: "test", Type: "testResource", ID: "a"},
+		registry.ResourceID{Source: registry.SourcePlugin, Namespace: "test", Type: "testResource", ID: "renamed"},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = reg.Load(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err := reg.ProcessAndDiff(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(diff) != 0 {
+		t.Fatalf("expected no changes after move, got: %v", diff)
+	}
+
+	state, err = reg.Dump()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	st, err := registry.UnmarshalState(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loaded := st.Resources
+
+	if len(loaded) != 2 || loaded[0].ID != "b" || len(loaded[0].Dependencies) != 1 || loaded[0].Dependencies[0].ID != "renamed" {
+		t.Fatalf("unexpected state after move: %s", state)
+	}
+}
+
+func TestMoveRuleWildcard(t *testing.T) {
+	id := &registry.ResourceID{Source: registry.SourceApp, Namespace: "old_app", Type: "testResource", ID: "db"}
+	from := registry.ResourceID{Source: registry.SourceApp, Namespace: "old_app"}
+
+	if (&registry.MoveRule{From: from}).Matches(id) {
+		t.Fatal("expected partial rule without wildcard not to match")
+	}
+
+	rule := &registry.MoveRule{From: from, To: registry.ResourceID{Namespace: "new_app"}, Wildcard: true}
+	if !rule.Matches(id) {
+		t.Fatal("expected wildcard rule to match")
+	}
+
+	if rule.Matches(&registry.ResourceID{Source: registry.SourceApp, Namespace: "other_app", Type: "testResource", ID: "db"}) {
+		t.Fatal("expected wildcard rule not to match other namespace")
+	}
+}
+
+func TestStateMigrations(t *testing.T) {
+	var migrated int
+
+	newRegistry := func() (*registry.Registry, *testResource) {
+		t.Helper()
+
+		reg := registry.NewRegistry(nil)
+		reg.RegisterMigrations(&testResource{}, func(props map[string]any) (map[string]any, error) {
+			migrated++
+			props["Name"] = props["Title"]
+			delete(props, "Title")
+
+			return props, nil
+		})
+
+		res := &testResource{Name: fields.String("db")}
+
+		_, err := reg.RegisterPluginResource("test", "db", res)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return reg, res
+	}
+
+	legacy := `[{"source": "plugin", "namespace": "test", "type": "testResource", "id": "db", "properties": {"Title": "db"}}]`
+
+	reg, res := newRegistry()
+
+	err := reg.Load([]byte(legacy))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if res.Name.Current() != "db" {
+		t.Fatalf("expected migrated field value, got: %q", res.Name.Current())
+	}
+
+	state, err := reg.Dump()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	st, err := registry.UnmarshalState(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if st.Version != registry.StateVersion || st.SchemaVersions["testResource"] != 1 {
+		t.Fatalf("unexpected state envelope: %s", state)
+	}
+
+	// Already migrated state is loaded as is.
+	reg, res = newRegistry()
+
+	err = reg.Load(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if res.Name.Current() != "db" || migrated != 1 {
+		t.Fatalf("expected field value to be kept without migrating again, got: %q (migrated %d times)", res.Name.Current(), migrated)
+	}
+}
+
+func TestStateMigrationsAfterMove(t *testing.T) {
+	reg := registry.NewRegistry(nil)
+	reg.RegisterMigrations(&testResource{},
+		func(props map[string]any) (map[string]any, error) {
+			t.Fatal("expected first migration to be skipped as stored schema version of moved type is 1")
+
+			return props, nil
+		},
+		func(props map[string]any) (map[string]any, error) {
+			props["Name"] = props["Title"]
+			delete(props, "Title")
+
+			return props, nil
+		},
+	)
+
+	res := &testResource{Name: fields.String("db")}
+
+	_, err := reg.RegisterPluginResource("test", "db", res)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = reg.Move(
+		registry.ResourceID{Source: registry.SourcePlugin, Namespace: "test", Type: "legacyResource", ID: "db"},
+		registry.ResourceID{Source: registry.SourcePlugin, Namespace: "test", Type: "testResource", ID: "db"},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state := `{"version": 1, "schema_versions": {"legacyResource": 1}, "resources": [
+		{"source": "plugin", "namespace": "test", "type": "legacyResource", "id": "db", "properties": {"Title": "db"}}
+	]}`
+
+	err = reg.Load([]byte(state))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if res.Name.Current() != "db" {
+		t.Fatalf("expected migrations of moved type to be applied, got: %q", res.Name.Current())
+	}
+}
+
+func TestSavedPlan(t *testing.T) {
+	ctx := context.Background()
+
+	plan := func(name string, saved *apiv1.Plan) (*registry.Registry, []*registry.Diff) {
+		t.Helper()
+
+		reg := registry.NewRegistry(&registry.Options{SavedPlan: saved})
+
+		_, err := reg.RegisterPluginResource("test", "db", &testResource{Name: fields.String(name)})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		diff, err := reg.ProcessAndDiff(ctx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return reg, diff
+	}
+
+	_, diff := plan("db", nil)
+	saved := &apiv1.Plan{Actions: registry.PlanActionFromDiff(diff)}
+
+	reg, diff := plan("db", saved)
+
+	err := reg.Apply(ctx, nil, diff, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reg, diff = plan("other", saved)
+
+	err = reg.Apply(ctx, nil, diff, nil)
+	if !errors.Is(err, registry.ErrPlanChanged) {
+		t.Fatalf("expected plan changed error, got: %v", err)
+	}
+
+	// Actions of other partitions and ones not created from registry are not compared.
+	saved.Actions = append(saved.Actions,
+		types.NewPlanActionCreate(registry.SourcePlugin, "test", "other", "testResource", "other", nil, false),
+		types.NewPlanActionUpdate("custom", "custom", "custom", "custom", "custom", nil, false),
+	)
+
+	reg, diff = plan("db", saved)
+
+	err = reg.Apply(ctx, nil, diff, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reg, diff = plan("db", saved)
+
+	_, err = reg.Partition("other").RegisterPluginResource("test", "other", &testResource{Name: fields.String("other")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = reg.Apply(ctx, nil, diff, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestApplyRollback(t *testing.T) {
+	ctx := context.Background()
+
+	reg := registry.NewRegistry(nil)
+
+	_, err := reg.RegisterPluginResource("test", "db", &testResource{Name: fields.String("v1")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	applyAll(t, reg)
+
+	state, err := reg.Dump()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reg = registry.NewRegistry(&registry.Options{Rollback: true})
+	db := &testResource{Name: fields.String("v2")}
+	app := &testResource{Name: fields.String("app"), Password: db.Name}
+
+	_, err = reg.RegisterPluginResource("test", "db", db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = reg.RegisterPluginResource("test", "app", app)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = reg.RegisterPluginResource("test", "failing", &flakyResource{Dep: app.Name, failures: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = reg.Load(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err := reg.ProcessAndDiff(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var rollbacks []string
+
+	err = reg.Apply(ctx, nil, diff, func(a *apiv1.ApplyAction) {
+		if a.Type == apiv1.PlanType_PLAN_TYPE_ROLLBACK && a.Progress == 1 {
+			rollbacks = append(rollbacks, a.ObjectId)
+		}
+	})
+	if !errors.Is(err, errTransient) {
+		t.Fatalf("expected apply error, got: %v", err)
+	}
+
+	if len(rollbacks) != 2 || rollbacks[0] != "app" || rollbacks[1] != "db" {
+		t.Fatalf("unexpected rollback actions: %v", rollbacks)
+	}
+
+	if db.Name.Current() != "v1" {
+		t.Fatalf("expected db to be reverted, got: %q", db.Name.Current())
+	}
+
+	state, err = reg.Dump()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	st, err := registry.UnmarshalState(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(st.Resources) != 1 || st.Resources[0].ID != "db" {
+		t.Fatalf("expected only db in state after rollback: %s", state)
+	}
+}
+
+func TestApplyRollbackOnPanic(t *testing.T) {
+	ctx := context.Background()
+	reg := registry.NewRegistry(&registry.Options{Rollback: true})
+	db := &testResource{Name: fields.String("db")}
+
+	_, err := reg.RegisterPluginResource("test", "db", db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = reg.RegisterPluginResource("test", "failing", &flakyResource{Dep: db.Name, panic: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err := reg.ProcessAndDiff(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var rollbacks []string
+
+	func() {
+		defer func() {
+			var pe *errgroup.PanicError
+
+			v := recover()
+			if err, ok := v.(error); !ok || !errors.As(err, &pe) || pe.Value != "create failed" {
+				t.Fatalf("expected apply to propagate panic, got: %v", v)
+			}
+		}()
+
+		_ = reg.Apply(ctx, nil, diff, func(a *apiv1.ApplyAction) {
+			if a.Type == apiv1.PlanType_PLAN_TYPE_ROLLBACK && a.Progress == 1 {
+				rollbacks = append(rollbacks, a.ObjectId)
+			}
+		})
+	}()
+
+	if len(rollbacks) != 1 || rollbacks[0] != "db" {
+		t.Fatalf("expected db to be rolled back, got: %v", rollbacks)
+	}
+}
+
+type ctxResource struct {
+	registry.ResourceBase
+
+	Name fields.StringInputField
+	Dep  fields.StringInputField
+
+	started, release chan struct{}
+	err              error
+	hasDeadline      bool
+}
+
+func (o *ctxResource) GetName() string {
+	return o.Name.Any()
+}
+
+func (o *ctxResource) Create(ctx context.Context, meta any) error {
+	if o.started == nil {
+		return nil
+	}
+
+	_, o.hasDeadline = ctx.Deadline()
+
+	close(o.started)
+
+	select {
+	case <-o.release:
+	case <-ctx.Done():
+	}
+
+	o.err = ctx.Err()
+
+	return o.err
+}
+
+func (o *ctxResource) Update(ctx context.Context, meta any) error {
+	return nil
+}
+
+func (o *ctxResource) Delete(ctx context.Context, meta any) error {
+	return nil
+}
+
+func TestApplyCancellation(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		cause     error
+		opErr     error
+		rollbacks int
+	}{
+		{name: "cancelled apply drains in-flight changes", cause: registry.ErrApplyCancelled},
+		{name: "other cancellation cancels in-flight changes", cause: context.Canceled, opErr: context.Canceled, rollbacks: 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			reg := registry.NewRegistry(&registry.Options{Rollback: true})
+			first := &ctxResource{Name: fields.String("first")}
+			inFlight := &ctxResource{Name: fields.String("in_flight"), Dep: first.Name, started: make(chan struct{}), release: make(chan struct{})}
+
+			_, err := reg.RegisterPluginResource("test", "first", first)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = reg.RegisterPluginResource("test", "in_flight", inFlight)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = reg.RegisterPluginResource("test", "last", &ctxResource{Name: fields.String("last"), Dep: inFlight.Name})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			diff, err := reg.ProcessAndDiff(context.Background(), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ctx, cancel := context.WithCancelCause(context.Background())
+			ctx, cancelTimeout := context.WithTimeout(ctx, time.Hour)
+
+			defer cancelTimeout()
+
+			go func() {
+				<-inFlight.started
+				cancel(tc.cause)
+
+				// Drained call finishes on its own, otherwise it has to be cancelled.
+				if tc.opErr == nil {
+					close(inFlight.release)
+				}
+			}()
+
+			var rollbacks int
+
+			err = reg.Apply(ctx, nil, diff, func(a *apiv1.ApplyAction) {
+				if a.Type == apiv1.PlanType_PLAN_TYPE_ROLLBACK && a.Progress == 1 {
+					rollbacks++
+				}
+			})
+			if !errors.Is(err, context.Canceled) {
+				t.Fatalf("expected apply to be cancelled, got: %v", err)
+			}
+
+			if !errors.Is(inFlight.err, tc.opErr) || !inFlight.hasDeadline {
+				t.Fatalf("unexpected in-flight call context: err=%v, deadline=%t", inFlight.err, inFlight.hasDeadline)
+			}
+
+			if rollbacks != tc.rollbacks {
+				t.Fatalf("expected %d rollbacks, got: %d", tc.rollbacks, rollbacks)
+			}
+		})
+	}
+}
+
+type slowResource struct {
+	registry.ResourceBase
+}
+
+func (o *slowResource) GetName() string {
+	return "slow"
+}
+
+func (o *slowResource) Timeouts() *registry.Timeouts {
+	return &registry.Timeouts{Create: 10 * time.Millisecond, Read: 10 * time.Millisecond}
+}
+
+func (o *slowResource) Import(ctx context.Context, meta any, externalID string) error {
+	<-ctx.Done()
+
+	return ctx.Err()
+}
+
+func (o *slowResource) Create(ctx context.Context, meta any) error {
+	<-ctx.Done()
+
+	return ctx.Err()
+}
+
+func (o *slowResource) Update(ctx context.Context, meta any) error {
+	return nil
+}
+
+func (o *slowResource) Delete(ctx context.Context, meta any) error {
+	return nil
+}
+
+func TestApplyTimeout(t *testing.T) {
+	ctx := context.Background()
+	reg := registry.NewRegistry(nil)
+
+	_, err := reg.RegisterPluginResource("test", "slow", &slowResource{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err := reg.ProcessAndDiff(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = reg.Apply(ctx, nil, diff, nil)
+
+	var timeoutErr *registry.TimeoutError
+	if !errors.As(err, &timeoutErr) || timeoutErr.ID != "slow" || timeoutErr.Operation != registry.OperationCreate {
+		t.Fatalf("expected create timeout error, got: %v", err)
+	}
+}
+
+func TestImportTimeout(t *testing.T) {
+	ctx := context.Background()
+	reg := registry.NewRegistry(nil)
+
+	_, err := reg.RegisterPluginResource("test", "slow", &slowResource{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = reg.Process(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	id := registry.ResourceIDFromProto(&apiv1.ResourceID{Source: "plugin", Namespace: "test", Type: "slowResource", Id: "slow"})
+
+	err = reg.Import(ctx, nil, id, "external-slow")
+
+	var timeoutErr *registry.TimeoutError
+	if !errors.As(err, &timeoutErr) || timeoutErr.Operation != registry.OperationImport || timeoutErr.Timeout != 10*time.Millisecond {
+		t.Fatalf("expected import timeout error with read timeout, got: %v", err)
+	}
+}
+
+func TestTracing(t *testing.T) {
+	sr := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+	reg := registry.NewRegistry(&registry.Options{TracerProvider: tp})
+
+	_, err := reg.RegisterPluginResource("test", "traced", &testResource{Name: fields.String("traced")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	applyAll(t, reg)
+
+	spans := make(map[string]sdktrace.ReadOnlySpan)
+
+	for _, s := range sr.Ended() {
+		spans[s.Name()] = s
+	}
+
+	for _, name := range []string{"registry.init", "registry.Diff", "registry.Apply", "resource.create"} {
+		if _, ok := spans[name]; !ok {
+			t.Fatalf("expected %s span, got: %v", name, spans)
+		}
+	}
+
+	create := spans["resource.create"]
+	if create.Parent().SpanID() != spans["registry.Apply"].SpanContext().SpanID() {
+		t.Fatal("expected resource span to be child of apply span")
+	}
+
+	for _, attr := range create.Attributes() {
+		if attr.Key == "resource.id" && attr.Value.AsString() == "plugin/test/testResource/traced" {
+			return
+		}
+	}
+
+	t.Fatalf("expected resource id attribute, got: %v", create.Attributes())
+}
+
+func TestDestroyFieldChanges(t *testing.T) {
+	register := func(reg *registry.Registry) {
+		t.Helper()
+
+		_, err := reg.RegisterPluginResource("test", "a", &testResource{Name: fields.String("a")})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	reg := registry.NewRegistry(nil)
+	register(reg)
+	applyAll(t, reg)
+
+	state, err := reg.Dump()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reg = registry.NewRegistry(&registry.Options{Destroy: true})
+	register(reg)
+
+	err = reg.Load(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err := reg.ProcessAndDiff(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(diff) != 1 || diff[0].Type != registry.DiffTypeDelete || len(diff[0].FieldChanges) != 1 {
+		t.Fatalf("expected single delete, got: %v", diff)
+	}
+
+	fc := diff[0].FieldChanges[0]
+	if fc.Name != "Name" || fc.Old != "a" || !fc.OldDefined || fc.NewDefined || fc.ForceNew {
+		t.Fatalf("expected only old value in delete field change, got: %+v", fc)
+	}
+}
+
+func TestDestroyFieldChangesMaskProxiedSensitive(t *testing.T) {
+	register := func(reg *registry.Registry) {
+		t.Helper()
+
+		db := &testResource{Name: fields.String("db"), Password: fields.String("hunter2")}
+
+		_, err := reg.RegisterPluginResource("test", "db", db)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = reg.RegisterPluginResource("test", "proxied", &testResource{Name: db.Password})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	reg := registry.NewRegistry(nil)
+	register(reg)
+	applyAll(t, reg)
+
+	state, err := reg.Dump()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reg = registry.NewRegistry(&registry.Options{Destroy: true})
+	register(reg)
+
+	err = reg.Load(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err := reg.ProcessAndDiff(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, action := range registry.PlanActionFromDiff(diff) {
+		for _, fc := range action.FieldChanges {
+			if strings.Contains(fc.OldValue.String(), "hunter2") {
+				t.Fatalf("sensitive value of %s not masked in delete: %v", action.ObjectName, fc)
+			}
+		}
+	}
+}
+
+func TestRetryBackoffJitter(t *testing.T) {
+	tests := []struct {
+		name   string
+		jitter float64
+		min    time.Duration
+		max    time.Duration
+	}{
+		{name: "none", jitter: 0, min: time.Second, max: time.Second},
+		{name: "half", jitter: 0.5, min: 500 * time.Millisecond, max: 1500 * time.Millisecond},
+		{name: "above one", jitter: 5, min: 0, max: 2 * time.Second},
+		{name: "negative", jitter: -1, min: time.Second, max: time.Second},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &registry.RetryPolicy{InitialBackoff: time.Second, Jitter: tc.jitter}
+
+			for range 1000 {
+				if b := p.Backoff(1); b < tc.min || b > tc.max {
+					t.Fatalf("expected backoff in [%s, %s], got: %s", tc.min, tc.max, b)
+				}
+			}
+		})
+	}
+}
+
+type readCounter struct {
+	n        int32
+	inFlight atomic.Int32
+	max      atomic.Int32
+	all      chan struct{}
+}
+
+type readResource struct {
+	registry.ResourceBase
+
+	Name    fields.StringInputField
+	counter *readCounter
+}
+
+func (o *readResource) GetName() string {
+	return o.Name.Any()
+}
+
+func (o *readResource) Read(ctx context.Context, meta any) error {
+	c := o.counter
+
+	cur := c.inFlight.Add(1)
+	defer c.inFlight.Add(-1)
+
+	for {
+		m := c.max.Load()
+		if cur <= m || c.max.CompareAndSwap(m, cur) {
+			break
+		}
+	}
+
+	if cur == c.n {
+		close(c.all)
+	}
+
+	select {
+	case <-c.all:
+	case <-time.After(time.Second):
+	}
+
+	return nil
+}
+
+func (o *readResource) Create(ctx context.Context, meta any) error {
+	return nil
+}
+
+func (o *readResource) Update(ctx context.Context, meta any) error {
+	return nil
+}
+
+func (o *readResource) Delete(ctx context.Context, meta any) error {
+	return nil
+}
+
+func TestReadConcurrency(t *testing.T) {
+	const n = 5
+
+	counter := &readCounter{n: n, all: make(chan struct{})}
+	reg := registry.NewRegistry(&registry.Options{Read: true, Concurrency: registry.Concurrency{Read: n}})
+
+	for i := range n {
+		name := fmt.Sprintf("r%d", i)
+
+		_, err := reg.RegisterPluginResource("test", name, &readResource{Name: fields.String(name), counter: counter})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_, err := reg.ProcessAndDiff(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := counter.max.Load(); got != n {
+		t.Fatalf("expected %d reads in flight, got: %d", n, got)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/outblocks/outblocks-plugin-go/registry/fields"
 	"github.com/outblocks/outblocks-plugin-go/util"
@@ -18,6 +19,8 @@ const (
 	ResourceStateDeleted
 )
 
+const SensitiveValueMask = "(sensitive)"
+
 var mutexKV = util.NewMutexKV()
 
 type Resource interface {
@@ -83,6 +86,12 @@ type ResourceCriticalChecker interface {
 	IsCritical(t DiffType, fieldList []string) bool
 }
 
+// ResourceDestroyPreventer allows resource to prevent its deletion or recreation,
+// same as prevent_destroy tag of embedded ResourceBase but per resource.
+type ResourceDestroyPreventer interface {
+	PreventDestroy() bool
+}
+
 type ResourceBase struct {
 	reg        *Registry
 	state      ResourceState
@@ -214,6 +223,74 @@ func (w *ResourceWrapper) String() string {
 	return fmt.Sprintf("ResourceWrapper<ID=%s,Type=%s,Ns=%s>", w.ID, w.Type, w.Namespace)
 }
 
+// DebugString returns description of resource with field values, sensitive ones are masked.
+func (w *ResourceWrapper) DebugString() string {
+	var fieldValues []string
+
+	for k, f := range w.Fields {
+		if f.Type.Properties.Ignored || f.Value.IsNil() {
+			continue
+		}
+
+		v, ok := fields.LookupCurrentValue(f.Value.Interface())
+		if !ok {
+			v, ok = fields.LookupWantedValue(f.Value.Interface())
+		}
+
+		if !ok {
+			continue
+		}
+
+		if w.isSensitiveField(f) {
+			v = SensitiveValueMask
+		}
+
+		fieldValues = append(fieldValues, fmt.Sprintf("%s=%v", k, v))
+	}
+
+	sort.Strings(fieldValues)
+
+	return fmt.Sprintf("ResourceWrapper<ID=%s,Type=%s,Ns=%s,Fields={%s}>", w.ID, w.Type, w.Namespace, strings.Join(fieldValues, ","))
+}
+
+// isSensitiveField returns true if field is sensitive or gets its value from sensitive field, e.g. through proxy.
+func (w *ResourceWrapper) isSensitiveField(f *FieldInfo) bool {
+	if f.Type.Properties.Sensitive {
+		return true
+	}
+
+	fdh, ok := f.Value.Interface().(fields.FieldDependencyHolder)
+	if !ok {
+		return false
+	}
+
+	for _, dep := range fdh.FieldDependencies() {
+		owner := w
+
+		if w.Resource != nil && w.Resource.Registry() != nil {
+			if rw, ok := w.Resource.Registry().fieldMap[dep]; ok {
+				owner = rw
+			}
+		}
+
+		for _, df := range owner.Fields {
+			if df.Type.Properties.Sensitive && !df.Value.IsNil() && df.Value.Interface() == dep {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (w *ResourceWrapper) stateEncrypter() StateEncrypter {
+	if w.Resource == nil || w.Resource.Registry() == nil {
+		return nil
+	}
+
+	return w.Resource.Registry().opts.StateEncrypter
+}
+
 func (w *ResourceWrapper) SetFieldValues(props map[string]any) error {
 	for k, v := range props {
 		f, ok := w.Fields[k]
@@ -221,6 +298,15 @@ func (w *ResourceWrapper) SetFieldValues(props map[string]any) error {
 			continue
 		}
 
+		if f.Type.Properties.Sensitive && isEncryptedValue(v) {
+			var err error
+
+			v, err = decryptValue(w.stateEncrypter(), v)
+			if err != nil {
+				return fmt.Errorf("%s.%s: %w", w.Type, k, err)
+			}
+		}
+
 		err := fields.SetFieldValue(f.Value.Interface(), v)
 		if err != nil {
 			return err
@@ -240,7 +326,20 @@ func (w *ResourceWrapper) FieldList() []string {
 	return f
 }
 
+func isExistingResource(rw *ResourceWrapper) bool {
+	return rw.Resource.State() == ResourceStateExisting
+}
+
 func (w *ResourceWrapper) MarshalJSON() ([]byte, error) {
+	rs, err := w.serialize(isExistingResource)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(rs)
+}
+
+func (w *ResourceWrapper) serialize(includeDep func(*ResourceWrapper) bool) (*ResourceSerialized, error) {
 	props := make(map[string]any)
 
 	for k, v := range w.Fields {
@@ -251,8 +350,19 @@ func (w *ResourceWrapper) MarshalJSON() ([]byte, error) {
 		f := v.Value.Interface().(fields.Field) //nolint:errcheck
 
 		val, ok := f.LookupCurrentRaw()
-		if ok {
-			props[k] = f.Serialize(val)
+		if !ok {
+			continue
+		}
+
+		props[k] = f.Serialize(val)
+
+		if enc := w.stateEncrypter(); v.Type.Properties.Sensitive && enc != nil {
+			encVal, err := encryptValue(enc, props[k])
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", w.Type, k, err)
+			}
+
+			props[k] = encVal
 		}
 	}
 
@@ -262,7 +372,7 @@ func (w *ResourceWrapper) MarshalJSON() ([]byte, error) {
 	)
 
 	for d := range w.DependedBy {
-		if d.Resource.State() == ResourceStateExisting {
+		if includeDep(d) {
 			dependedBy = append(dependedBy, d.ResourceID)
 		}
 	}
@@ -272,7 +382,7 @@ func (w *ResourceWrapper) MarshalJSON() ([]byte, error) {
 	})
 
 	for d := range w.Dependencies {
-		if d.Resource.State() == ResourceStateExisting {
+		if includeDep(d) {
 			deps = append(deps, d.ResourceID)
 		}
 	}
@@ -287,14 +397,14 @@ func (w *ResourceWrapper) MarshalJSON() ([]byte, error) {
 		refID = rr.ReferenceID()
 	}
 
-	return json.Marshal(ResourceSerialized{
+	return &ResourceSerialized{
 		IsNew:        w.Resource.IsNew(),
 		ResourceID:   w.ResourceID,
 		ReferenceID:  refID,
 		Properties:   props,
 		Dependencies: deps,
 		DependedBy:   dependedBy,
-	})
+	}, nil
 }
 
 func (w *ResourceWrapper) MarkAllWantedAsCurrent() {
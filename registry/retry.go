@@ -0,0 +1,94 @@
+package registry
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"time"
+)
+
+const (
+	defaultRetryInitialBackoff = time.Second
+	defaultRetryMaxBackoff     = 30 * time.Second
+	defaultRetryMultiplier     = 2
+)
+
+type RetryPolicy struct {
+	MaxAttempts    int           // total number of attempts, values lower than 2 disable retries
+	InitialBackoff time.Duration // defaults to 1s
+	MaxBackoff     time.Duration // defaults to 30s
+	Multiplier     float64       // defaults to 2
+	Jitter         float64       // randomization factor of backoff in range [0, 1], values above 1 are treated as 1
+
+	// Retryable decides if error should be retried, if nil all errors are retried.
+	Retryable func(error) bool
+}
+
+// ResourceRetrier allows resource to define its own retry policy for Create/Update/Delete/Process calls.
+type ResourceRetrier interface {
+	RetryPolicy() *RetryPolicy
+}
+
+func (p *RetryPolicy) Backoff(attempt int) time.Duration {
+	initial := p.InitialBackoff
+	if initial <= 0 {
+		initial = defaultRetryInitialBackoff
+	}
+
+	maxBackoff := p.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = defaultRetryMaxBackoff
+	}
+
+	mult := p.Multiplier
+	if mult <= 0 {
+		mult = defaultRetryMultiplier
+	}
+
+	backoff := math.Min(float64(initial)*math.Pow(mult, float64(attempt-1)), float64(maxBackoff))
+
+	// Jitter above 1 could make backoff negative.
+	if jitter := math.Min(p.Jitter, 1); jitter > 0 {
+		backoff *= 1 + jitter*(2*rand.Float64()-1) //nolint:gosec
+	}
+
+	return time.Duration(backoff)
+}
+
+func (p *RetryPolicy) isRetryable(err error) bool {
+	if p.Retryable == nil {
+		return true
+	}
+
+	return p.Retryable(err)
+}
+
+// Run calls f until it succeeds, returns non retryable error or max attempts is reached.
+// onRetry is called before each retry with attempt number (starting from 2) and previous error.
+func (p *RetryPolicy) Run(ctx context.Context, f func() error, onRetry func(attempt int, err error)) error {
+	err := f()
+
+	if p == nil {
+		return err
+	}
+
+	for attempt := 2; err != nil && attempt <= p.MaxAttempts && p.isRetryable(err); attempt++ {
+		t := time.NewTimer(p.Backoff(attempt - 1))
+
+		select {
+		case <-ctx.Done():
+			t.Stop()
+
+			return err
+		case <-t.C:
+		}
+
+		if onRetry != nil {
+			onRetry(attempt, err)
+		}
+
+		err = f()
+	}
+
+	return err
+}
@@ -0,0 +1,171 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	apiv1 "github.com/outblocks/outblocks-plugin-go/gen/api/v1"
+	"github.com/outblocks/outblocks-plugin-go/registry/fields"
+)
+
+// rollbackSnapshot keeps current values of updated resources captured before apply.
+type rollbackSnapshot map[*ResourceWrapper]map[string]any
+
+func newRollbackSnapshot(diff []*Diff) rollbackSnapshot {
+	ret := make(rollbackSnapshot)
+
+	for _, d := range diff {
+		if d.Type != DiffTypeUpdate {
+			continue
+		}
+
+		vals := make(map[string]any)
+
+		for name, f := range d.Object.Fields {
+			if f.Type.Properties.Ignored || f.Value.IsNil() {
+				continue
+			}
+
+			// Values resolved from dependencies cannot be reverted.
+			if _, ok := f.Value.Interface().(fields.FieldDependencyHolder); ok {
+				continue
+			}
+
+			// Only input fields are reverted, outputs are set by resource itself.
+			if fld, ok := f.Value.Interface().(fields.InputField); !ok || fld.IsOutput() {
+				continue
+			}
+
+			if v, ok := fields.LookupCurrentValue(f.Value.Interface()); ok {
+				vals[name] = v
+			}
+		}
+
+		ret[d.Object] = vals
+	}
+
+	return ret
+}
+
+func rollbackAction(d *Diff, step int) *apiv1.ApplyAction {
+	a := d.ToApplyAction(step, 1)
+	a.Type = apiv1.PlanType_PLAN_TYPE_ROLLBACK
+
+	return a
+}
+
+// rollbackOrder returns applied creates and updates with dependents first.
+func rollbackOrder(diff []*Diff) []*Diff {
+	pending := make(map[*ResourceWrapper]*Diff)
+
+	for _, d := range diff {
+		if (d.Type == DiffTypeCreate || d.Type == DiffTypeUpdate) && d.Applied() {
+			pending[d.Object] = d
+		}
+	}
+
+	var ret []*Diff
+
+	for len(pending) > 0 {
+		var ready []*Diff
+
+		for rw, d := range pending {
+			blocked := false
+
+			for dep := range rw.DependedBy {
+				if _, ok := pending[dep]; ok {
+					blocked = true
+
+					break
+				}
+			}
+
+			if !blocked {
+				ready = append(ready, d)
+			}
+		}
+
+		// Should not happen as cycles are detected before apply, fallback to remaining ones.
+		if len(ready) == 0 {
+			for _, d := range pending {
+				ready = append(ready, d)
+			}
+		}
+
+		sort.Slice(ready, func(i, j int) bool {
+			return ready[i].Object.Less(&ready[j].Object.ResourceID)
+		})
+
+		for _, d := range ready {
+			delete(pending, d.Object)
+		}
+
+		ret = append(ret, ready...)
+	}
+
+	return ret
+}
+
+func (r *Registry) rollbackDiff(ctx context.Context, meta any, d *Diff, snapshot rollbackSnapshot) error {
+	policy := r.retryPolicy(d)
+
+	switch d.Type {
+	case DiffTypeCreate:
+		err := policy.Run(ctx, func() error {
+			return withResourceTimeout(ctx, d.Object, OperationDelete, func(ctx context.Context) error {
+				return d.Object.Resource.(ResourceCUD).Delete(ctx, meta) //nolint:errcheck
+			})
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		d.Object.UnsetAllCurrent()
+		d.Object.Resource.SetState(ResourceStateNew)
+
+	case DiffTypeUpdate:
+		for name, v := range snapshot[d.Object] {
+			err := fields.SetWantedValue(d.Object.Fields[name].Value.Interface(), v)
+			if err != nil {
+				return err
+			}
+		}
+
+		err := policy.Run(ctx, func() error {
+			return withResourceTimeout(ctx, d.Object, OperationUpdate, func(ctx context.Context) error {
+				return d.Object.Resource.(ResourceCUD).Update(ctx, meta) //nolint:errcheck
+			})
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		d.Object.MarkAllWantedAsCurrent()
+
+	case DiffTypeNone, DiffTypeDelete, DiffTypeRecreate, DiffTypeProcess:
+	}
+
+	return nil
+}
+
+// rollback reverts applied changes: created resources are deleted and updated ones get previous values back.
+// Deleted and recreated resources cannot be restored.
+func (r *Registry) rollback(ctx context.Context, meta any, diff []*Diff, snapshot rollbackSnapshot, callback func(*apiv1.ApplyAction), cp *checkpointer) error {
+	for _, d := range rollbackOrder(diff) {
+		callback(rollbackAction(d, 0))
+
+		err := r.rollbackDiff(ctx, meta, d, snapshot)
+		if err != nil {
+			return fmt.Errorf("rolling back changes to %s '%s' error: %w", d.ObjectType(), d.Object.Resource.GetName(), err)
+		}
+
+		if cp != nil {
+			cp.Update(d.Object)
+		}
+
+		callback(rollbackAction(d, 1))
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package registry
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// StateVersion is a version of registry dump format. Dumps without version (bare array of resources) are version 0.
+const StateVersion = 1
+
+// State is a versioned envelope of registry dump.
+// SchemaVersions holds schema version of each resource type that has migrations registered.
+type State struct {
+	Version        int                   `json:"version"`
+	SchemaVersions map[string]int        `json:"schema_versions,omitempty"`
+	Resources      []*ResourceSerialized `json:"resources"`
+}
+
+// MigrationFunc upgrades stored properties of resource by one schema version.
+// Sensitive values are passed as stored, e.g. encrypted if state encrypter is used.
+type MigrationFunc func(props map[string]any) (map[string]any, error)
+
+// UnmarshalState decodes registry dump, including legacy one without envelope.
+func UnmarshalState(data []byte) (*State, error) {
+	data = bytes.TrimSpace(data)
+
+	if len(data) > 0 && data[0] == '[' {
+		var resources []*ResourceSerialized
+
+		err := json.Unmarshal(data, &resources)
+		if err != nil {
+			return nil, err
+		}
+
+		return &State{Resources: resources}, nil
+	}
+
+	var state State
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return nil, err
+	}
+
+	if state.Version > StateVersion {
+		return nil, fmt.Errorf("registry state version %d is newer than supported version %d, upgrade plugin", state.Version, StateVersion)
+	}
+
+	return &state, nil
+}
+
+func resourceTypeName(o Resource) string {
+	t := reflect.TypeOf(o)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t.Name()
+}
+
+// RegisterMigrations adds upgrade functions for resource type, e.g. after renaming its field.
+// Each function upgrades properties by one version, so the schema version of type is a total number of its migrations.
+// Migrations have to be registered before Load and are run after moves, so resources moved from other type
+// are migrated with migrations of their new type starting from schema version stored for the old type.
+func (r *Registry) RegisterMigrations(o Resource, migrations ...MigrationFunc) {
+	typ := resourceTypeName(o)
+
+	r.migrations[typ] = append(r.migrations[typ], migrations...)
+}
+
+func (r *Registry) schemaVersions(resources []*ResourceSerialized) map[string]int {
+	var ret map[string]int
+
+	for _, rs := range resources {
+		if v := len(r.migrations[rs.Type]); v > 0 {
+			if ret == nil {
+				ret = make(map[string]int)
+			}
+
+			ret[rs.Type] = v
+		}
+	}
+
+	return ret
+}
+
+func (r *Registry) marshalState(resources []*ResourceSerialized) ([]byte, error) {
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].Less(&resources[j].ResourceID)
+	})
+
+	return json.Marshal(&State{
+		Version:        StateVersion,
+		SchemaVersions: r.schemaVersions(resources),
+		Resources:      resources,
+	})
+}
+
+// migrate upgrades properties of loaded resources to current schema version of their types.
+// It runs after moves, storedTypes holds type of each resource as stored and is used to look up its stored
+// schema version, so when type is renamed with a move, new type continues schema versions of the old one.
+func (r *Registry) migrate(state *State, storedTypes []string) error {
+	for i, rs := range state.Resources {
+		migrations := r.migrations[rs.Type]
+
+		if rs.Properties == nil {
+			rs.Properties = make(map[string]any)
+		}
+
+		from := state.SchemaVersions[storedTypes[i]]
+		if from > len(migrations) {
+			return fmt.Errorf("%s: stored schema version %d is newer than supported version %d", rs.Type, from, len(migrations))
+		}
+
+		for i := from; i < len(migrations); i++ {
+			props, err := migrations[i](rs.Properties)
+			if err != nil {
+				return fmt.Errorf("%s: error migrating %s to schema version %d: %w", rs.Type, resourceIDPath(&rs.ResourceID), i+1, err)
+			}
+
+			rs.Properties = props
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package registry
+
+import (
+	"fmt"
+
+	"github.com/gobwas/glob"
+)
+
+// ResourceTarget matches resources by glob patterns of their ID parts. Empty pattern matches anything.
+type ResourceTarget struct {
+	Source, Namespace, Type, ID string
+
+	globs [4]glob.Glob
+}
+
+func NewResourceTarget(source, namespace, typ, id string) (*ResourceTarget, error) {
+	t := &ResourceTarget{
+		Source:    source,
+		Namespace: namespace,
+		Type:      typ,
+		ID:        id,
+	}
+
+	for i, pat := range []string{source, namespace, typ, id} {
+		if pat == "" {
+			continue
+		}
+
+		g, err := glob.Compile(pat)
+		if err != nil {
+			return nil, fmt.Errorf("invalid resource target pattern '%s': %w", pat, err)
+		}
+
+		t.globs[i] = g
+	}
+
+	return t, nil
+}
+
+func (t *ResourceTarget) Matches(id *ResourceID) bool {
+	for i, v := range []string{id.Source, id.Namespace, id.Type, id.ID} {
+		if t.globs[i] != nil && !t.globs[i].Match(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (t *ResourceTarget) String() string {
+	return fmt.Sprintf("%s/%s/%s/%s", t.Source, t.Namespace, t.Type, t.ID)
+}
+
+func addTargetedRecursive(rw *ResourceWrapper, ret map[*ResourceWrapper]struct{}, dependents bool) {
+	if _, ok := ret[rw]; ok {
+		return
+	}
+
+	ret[rw] = struct{}{}
+
+	next := rw.Dependencies
+	if dependents {
+		next = rw.DependedBy
+	}
+
+	for dep := range next {
+		addTargetedRecursive(dep, ret, dependents)
+	}
+}
+
+// addDeletedDependents adds transitive dependents that are deleted together with rw, same as deleteObjectTree does
+// for resources that are no longer registered.
+func addDeletedDependents(rw *ResourceWrapper, ret map[*ResourceWrapper]struct{}) {
+	for dep := range rw.DependedBy {
+		if !dep.Resource.IsRegistered() {
+			ret[dep] = struct{}{}
+		}
+
+		addDeletedDependents(dep, ret)
+	}
+}
+
+// targeted returns resources matching targets with their transitive dependencies
+// (or dependents when destroying). Deleted targets also include dependents deleted with them,
+// otherwise their deletion would wait for changes that are filtered out. Nil is returned if no targets are set.
+func (r *Registry) targeted() map[*ResourceWrapper]struct{} {
+	if len(r.opts.Targets) == 0 {
+		return nil
+	}
+
+	ret := make(map[*ResourceWrapper]struct{})
+
+	for _, rw := range r.resources {
+		for _, t := range r.opts.Targets {
+			if t.Matches(&rw.ResourceID) {
+				addTargetedRecursive(rw, ret, r.opts.Destroy)
+
+				if !rw.Resource.IsRegistered() {
+					addDeletedDependents(rw, ret)
+				}
+
+				break
+			}
+		}
+	}
+
+	return ret
+}
@@ -0,0 +1,119 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+type Operation string
+
+const (
+	OperationCreate  Operation = "create"
+	OperationUpdate  Operation = "update"
+	OperationDelete  Operation = "delete"
+	OperationRead    Operation = "read"
+	OperationProcess Operation = "process"
+	OperationImport  Operation = "import"
+)
+
+// Timeouts limit duration of each resource operation call, zero value means no limit.
+type Timeouts struct {
+	Create  time.Duration
+	Update  time.Duration
+	Delete  time.Duration
+	Read    time.Duration
+	Process time.Duration
+	// Import defaults to Read timeout if not set.
+	Import time.Duration
+}
+
+// ResourceTimeouts allows resource to define timeouts of its Create/Update/Delete/Read/Process/Import calls.
+type ResourceTimeouts interface {
+	Timeouts() *Timeouts
+}
+
+type TimeoutError struct {
+	ResourceID
+	Operation Operation
+	Timeout   time.Duration
+	Err       error
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("%s of %s timed out after %s: %s", e.Operation, resourceIDPath(&e.ResourceID), e.Timeout, e.Err)
+}
+
+func (e *TimeoutError) Unwrap() error {
+	return e.Err
+}
+
+func (t *Timeouts) timeout(op Operation) time.Duration {
+	switch op {
+	case OperationCreate:
+		return t.Create
+	case OperationUpdate:
+		return t.Update
+	case OperationDelete:
+		return t.Delete
+	case OperationRead:
+		return t.Read
+	case OperationProcess:
+		return t.Process
+	case OperationImport:
+		if t.Import > 0 {
+			return t.Import
+		}
+
+		return t.Read
+	}
+
+	return 0
+}
+
+func resourceTimeout(rw *ResourceWrapper, op Operation) time.Duration {
+	rt, ok := rw.Resource.(ResourceTimeouts)
+	if !ok {
+		return 0
+	}
+
+	t := rt.Timeouts()
+	if t == nil {
+		return 0
+	}
+
+	return t.timeout(op)
+}
+
+// withResourceTimeout calls f in span of operation with context limited by resource timeout of operation.
+func withResourceTimeout(ctx context.Context, rw *ResourceWrapper, op Operation, f func(ctx context.Context) error) error {
+	ctx, span := startResourceSpan(ctx, rw, op)
+	err := runWithTimeout(ctx, rw, op, f)
+
+	endSpan(span, err)
+
+	return err
+}
+
+func runWithTimeout(ctx context.Context, rw *ResourceWrapper, op Operation, f func(ctx context.Context) error) error {
+	timeout := resourceTimeout(rw, op)
+	if timeout <= 0 {
+		return f(ctx)
+	}
+
+	tctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := f(tctx)
+	if err != nil && ctx.Err() == nil && errors.Is(tctx.Err(), context.DeadlineExceeded) {
+		return &TimeoutError{
+			ResourceID: rw.ResourceID,
+			Operation:  op,
+			Timeout:    timeout,
+			Err:        err,
+		}
+	}
+
+	return err
+}
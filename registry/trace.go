@@ -0,0 +1,45 @@
+package registry
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/outblocks/outblocks-plugin-go/registry"
+
+func (r *Registry) tracer() trace.Tracer {
+	if r.opts.TracerProvider != nil {
+		return r.opts.TracerProvider.Tracer(tracerName)
+	}
+
+	return otel.GetTracerProvider().Tracer(tracerName)
+}
+
+func resourceAttributes(rw *ResourceWrapper) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("resource.id", resourceIDPath(&rw.ResourceID)),
+		attribute.String("resource.source", rw.Source),
+		attribute.String("resource.namespace", rw.Namespace),
+		attribute.String("resource.type", rw.Type),
+		attribute.String("resource.name", rw.Resource.GetName()),
+	}
+}
+
+// startResourceSpan starts span of resource operation as child of span in ctx, using its tracer provider.
+func startResourceSpan(ctx context.Context, rw *ResourceWrapper, op Operation) (context.Context, trace.Span) {
+	return trace.SpanFromContext(ctx).TracerProvider().Tracer(tracerName).Start(ctx, "resource."+string(op),
+		trace.WithAttributes(resourceAttributes(rw)...))
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
@@ -19,7 +19,7 @@ type RandomString struct {
 	Numeric fields.BoolInputField `state:"force_new" default:"true"`
 	Special fields.BoolInputField `state:"force_new" default:"true"`
 
-	Result fields.StringOutputField
+	Result fields.StringOutputField `state:"sensitive"`
 }
 
 func (o *RandomString) GetID() string {
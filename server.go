@@ -2,11 +2,12 @@ package plugin
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"math/rand"
-	"net"
 	"os"
 	"os/signal"
 	"syscall"
@@ -14,18 +15,48 @@ import (
 
 	"github.com/outblocks/outblocks-plugin-go/env"
 	apiv1 "github.com/outblocks/outblocks-plugin-go/gen/api/v1"
+	"github.com/outblocks/outblocks-plugin-go/log"
+	"github.com/outblocks/outblocks-plugin-go/registry"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/health"
 )
 
-const ProtocolV1 = "v1"
+const (
+	ProtocolV1 = "v1"
+	// ProtocolV2 adds registry state checkpoints streamed during apply.
+	ProtocolV2 = "v2"
+)
 
 type RegistryOptions struct {
 	AllowDuplicates bool
+	StateEncrypter  registry.StateEncrypter
+	RetryPolicy     *registry.RetryPolicy
+	Concurrency     registry.Concurrency
+
+	CheckpointInterval time.Duration
+	Rollback           bool
+	TracerProvider     trace.TracerProvider
 }
 
 type Server struct {
-	env env.Enver
+	env      env.Enver
+	hostCli  apiv1.HostServiceClient
+	applies  *applyCanceler
+	cert     *tls.Certificate
+	protocol string
+
+	unaryInterceptors  []grpc.UnaryServerInterceptor
+	streamInterceptors []grpc.StreamServerInterceptor
+	requestLogging     bool
+
+	health     *health.Server
+	reflection bool
+
+	bufferedLogging *log.BufferedOptions
+	slogDefault     bool
+	slogLevel       slog.Leveler
 
 	registryOptions RegistryOptions
 }
@@ -34,7 +65,9 @@ func newServer() *Server {
 	rand.Seed(time.Now().UnixNano()) //nolint
 
 	return &Server{
-		env: env.NewEnv(),
+		env:      env.NewEnv(),
+		applies:  newApplyCanceler(),
+		protocol: negotiateProtocol(os.Getenv(EnvHostProtocol)),
 	}
 }
 
@@ -46,48 +79,111 @@ func WithRegistryAllowDuplicates(b bool) ServerOption {
 	}
 }
 
-func (s *Server) serve(handler BasicPluginHandler, opts ...ServerOption) error {
-	for _, opt := range opts {
-		opt(s)
+// WithRegistryStateEncrypter enables encryption of sensitive fields in registry state.
+func WithRegistryStateEncrypter(enc registry.StateEncrypter) ServerOption {
+	return func(s *Server) {
+		s.registryOptions.StateEncrypter = enc
 	}
+}
 
-	// Disable grpc client logging.
-	grpclog.SetLoggerV2(grpclog.NewLoggerV2(io.Discard, io.Discard, io.Discard))
+// WithRegistryRetryPolicy sets default retry policy for resources that do not define their own.
+func WithRegistryRetryPolicy(p *registry.RetryPolicy) ServerOption {
+	return func(s *Server) {
+		s.registryOptions.RetryPolicy = p
+	}
+}
 
-	handshake := Handshake{
-		Protocol: ProtocolV1,
+func WithRegistryConcurrency(c registry.Concurrency) ServerOption {
+	return func(s *Server) {
+		s.registryOptions.Concurrency = c
 	}
+}
 
-	lCfg := net.ListenConfig{}
+func WithRegistryCheckpointInterval(d time.Duration) ServerOption {
+	return func(s *Server) {
+		s.registryOptions.CheckpointInterval = d
+	}
+}
 
-	l, err := lCfg.Listen(context.TODO(), "tcp4", "")
-	if err != nil {
-		panic(err)
+// WithRegistryRollback makes registry revert already applied changes when apply fails.
+func WithRegistryRollback(b bool) ServerOption {
+	return func(s *Server) {
+		s.registryOptions.Rollback = b
 	}
+}
 
-	handshake.Addr = l.Addr().String()
+func WithEnv(e env.Enver) ServerOption {
+	return func(s *Server) {
+		s.env = e
+	}
+}
 
-	out, err := json.Marshal(handshake)
-	if err != nil {
-		return err
+// WithBufferedLogging makes logger passed to Init send lines in batches in background instead of one call per line.
+// Lines that cannot be delivered are written to fallback, by default to a file in plugin project cache dir.
+func WithBufferedLogging(opts *log.BufferedOptions) ServerOption {
+	return func(s *Server) {
+		if opts == nil {
+			opts = &log.BufferedOptions{}
+		}
+
+		s.bufferedLogging = opts
 	}
+}
 
-	fmt.Println(string(out)) //nolint:forbidigo
+// WithSlogDefault makes Init replace default slog logger with one sending records at or above level to host
+// the same way as logger passed to Init, so that output of libraries logging via slog does not end up on stdout
+// reserved for handshake.
+// Nil level defaults to slog.LevelInfo. Default logger is replaced only once, even if Init is called again.
+func WithSlogDefault(level slog.Leveler) ServerOption {
+	return func(s *Server) {
+		s.slogDefault = true
+		s.slogLevel = level
+	}
+}
+
+// WithHostProtocol overrides protocol version of host read from EnvHostProtocol, e.g. when serving in-process.
+func WithHostProtocol(protocol string) ServerOption {
+	return func(s *Server) {
+		s.protocol = negotiateProtocol(protocol)
+	}
+}
+
+// WithHostClient makes Init use provided host client instead of dialing host address.
+func WithHostClient(cli apiv1.HostServiceClient) ServerOption {
+	return func(s *Server) {
+		s.hostCli = cli
+	}
+}
+
+func (s *Server) newGRPCServer(handler BasicPluginHandler, grpcOpts ...grpc.ServerOption) (*grpc.Server, *basicPluginHandlerWrapper) {
+	basicWrapper := &basicPluginHandlerWrapper{
+		BasicPluginHandler: handler,
+		env:                s.env,
+		hostCli:            s.hostCli,
+		cert:               s.cert,
+		health:             s.health,
+		bufferedLogging:    s.bufferedLogging,
+		slogDefault:        s.slogDefault,
+		slogLevel:          s.slogLevel,
+	}
 
-	grpcServer := grpc.NewServer()
-	basicWrapper := &basicPluginHandlerWrapper{BasicPluginHandler: handler, env: s.env}
+	grpcOpts = append(grpcOpts, s.tracingOptions()...)
+	grpcServer := grpc.NewServer(append(grpcOpts, s.interceptors(basicWrapper)...)...)
 	apiv1.RegisterBasicPluginServiceServer(grpcServer, basicWrapper)
 
+	// Older hosts do not know checkpoint responses of apply streams.
+	checkpoints := protocolAtLeast(s.protocol, ProtocolV2)
+
 	if srv, ok := handler.(DeployPluginHandler); ok {
-		apiv1.RegisterDeployPluginServiceServer(grpcServer, &deployPluginHandlerWrapper{DeployPluginHandler: srv, RegistryOptions: s.registryOptions})
+		apiv1.RegisterDeployPluginServiceServer(grpcServer, &deployPluginHandlerWrapper{DeployPluginHandler: srv, RegistryOptions: s.registryOptions, applies: s.applies, checkpoints: checkpoints})
 	}
 
 	if srv, ok := handler.(DNSPluginHandler); ok {
-		apiv1.RegisterDNSPluginServiceServer(grpcServer, &dnsPluginHandlerWrapper{DNSPluginHandler: srv, RegistryOptions: s.registryOptions})
+		apiv1.RegisterDNSPluginServiceServer(grpcServer, &dnsPluginHandlerWrapper{DNSPluginHandler: srv, RegistryOptions: s.registryOptions, applies: s.applies, checkpoints: checkpoints})
 	}
 
 	if srv, ok := handler.(MonitoringPluginHandler); ok {
-		apiv1.RegisterMonitoringPluginServiceServer(grpcServer, &monitoringPluginHandlerWrapper{MonitoringPluginHandler: srv, RegistryOptions: s.registryOptions})
+		apiv1.RegisterMonitoringPluginServiceServer(grpcServer, &monitoringPluginHandlerWrapper{MonitoringPluginHandler: srv, RegistryOptions: s.registryOptions, applies: s.applies, checkpoints: checkpoints})
 	}
 
 	if srv, ok := handler.(LogsPluginHandler); ok {
@@ -118,7 +214,46 @@ func (s *Server) serve(handler BasicPluginHandler, opts ...ServerOption) error {
 		apiv1.RegisterSecretPluginServiceServer(grpcServer, srv)
 	}
 
-	// Handle SIGINT and SIGTERM.
+	s.registerDebugServices(grpcServer)
+
+	return grpcServer, basicWrapper
+}
+
+func (s *Server) serve(handler BasicPluginHandler, opts ...ServerOption) error {
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	// Disable grpc client logging.
+	grpclog.SetLoggerV2(grpclog.NewLoggerV2(io.Discard, io.Discard, io.Discard))
+
+	t, err := newTransport(context.TODO())
+	if err != nil {
+		return err
+	}
+
+	defer t.cleanup()
+
+	s.cert = t.cert
+
+	shutdownTracing, err := s.tracerProviderFromEnv()
+	if err != nil {
+		return err
+	}
+
+	defer shutdownTracing()
+
+	grpcServer, basicWrapper := s.newGRPCServer(handler, t.serverOptions()...)
+
+	out, err := json.Marshal(s.handshake(t, handler))
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(out)) //nolint:forbidigo
+
+	// Handle SIGINT and SIGTERM. SIGINT (e.g. Ctrl-C in host terminal) gracefully cancels in-flight applies
+	// so that host still receives partial state, server is stopped only on SIGTERM.
 	ch := make(chan os.Signal, 1)
 	signal.Notify(ch, syscall.SIGINT, syscall.SIGTERM)
 
@@ -129,16 +264,21 @@ func (s *Server) serve(handler BasicPluginHandler, opts ...ServerOption) error {
 			if sig == syscall.SIGTERM {
 				break
 			}
-		}
 
-		grpcServer.GracefulStop()
+			s.applies.cancelAll()
+		}
 
-		if basicWrapper.conn != nil {
-			basicWrapper.conn.Close()
+		if s.health != nil {
+			s.health.Shutdown()
 		}
+
+		grpcServer.GracefulStop()
 	}()
 
-	err = grpcServer.Serve(l)
+	err = grpcServer.Serve(t.listener)
+
+	// Flush logs and close host connection after in-flight requests finished.
+	basicWrapper.close()
 
 	if pc, ok := handler.(Cleanup); ok {
 		errCleanup := pc.Cleanup()
@@ -153,3 +293,17 @@ func (s *Server) serve(handler BasicPluginHandler, opts ...ServerOption) error {
 func Serve(handler BasicPluginHandler, opts ...ServerOption) error {
 	return newServer().serve(handler, opts...)
 }
+
+// NewGRPCServer creates gRPC server with all services implemented by handler registered, without listening on anything.
+// Returned close func flushes logger passed to Init and closes host connection, it should be called after server stopped.
+func NewGRPCServer(handler BasicPluginHandler, opts ...ServerOption) (*grpc.Server, func()) {
+	s := newServer()
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	grpcServer, basicWrapper := s.newGRPCServer(handler)
+
+	return grpcServer, basicWrapper.close
+}
@@ -2,13 +2,23 @@ package plugin
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
+	"log/slog"
+	"math"
+	"os"
+	"sync"
 
 	"github.com/outblocks/outblocks-plugin-go/env"
 	apiv1 "github.com/outblocks/outblocks-plugin-go/gen/api/v1"
 	"github.com/outblocks/outblocks-plugin-go/log"
 	"github.com/outblocks/outblocks-plugin-go/registry"
+	"github.com/outblocks/outblocks-plugin-go/types"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 type BasicPluginHandler interface {
@@ -22,6 +32,16 @@ type DeployPluginHandler interface {
 	Apply(*apiv1.ApplyRequest, *registry.Registry, apiv1.DeployPluginService_ApplyServer) error
 }
 
+// DeployImportHandler can be optionally implemented by DeployPluginHandler to support importing existing resources.
+type DeployImportHandler interface {
+	Import(context.Context, *registry.Registry, *apiv1.ImportRequest) (*apiv1.ImportResponse, error)
+}
+
+// DeployDriftHandler can be optionally implemented by DeployPluginHandler to support drift detection.
+type DeployDriftHandler interface {
+	DetectDrift(context.Context, *registry.Registry, *apiv1.DetectDriftRequest) (*apiv1.DetectDriftResponse, error)
+}
+
 type DNSPluginHandler interface {
 	PlanDNS(context.Context, *registry.Registry, *apiv1.PlanDNSRequest) (*apiv1.PlanDNSResponse, error)
 	ApplyDNS(*apiv1.ApplyDNSRequest, *registry.Registry, apiv1.DNSPluginService_ApplyDNSServer) error
@@ -65,31 +85,248 @@ type Cleanup interface {
 }
 
 type basicPluginHandlerWrapper struct {
-	env  env.Enver
-	conn *grpc.ClientConn
+	env     env.Enver
+	conn    *grpc.ClientConn
+	hostCli apiv1.HostServiceClient
+	cert    *tls.Certificate
+	health  *health.Server
 	BasicPluginHandler
+
+	bufferedLogging *log.BufferedOptions
+	closeLog        func()
+	slogDefault     bool
+	slogLevel       slog.Leveler
+	slogOnce        sync.Once
+
+	mu          sync.Mutex
+	log         log.Logger
+	initialized bool
+}
+
+func (s *basicPluginHandlerWrapper) newLogger(cli apiv1.HostServiceClient) log.Logger {
+	if s.bufferedLogging == nil {
+		return log.NewLogger(cli)
+	}
+
+	opts := *s.bufferedLogging
+
+	var f *os.File
+
+	if dir := s.env.PluginProjectCacheDir(); opts.Fallback == nil && dir != "" {
+		f, _ = log.OpenFallbackFile(dir)
+		if f != nil {
+			opts.Fallback = f
+		}
+	}
+
+	l := log.NewBufferedLogger(cli, &opts)
+	s.closeLog = func() {
+		l.Close()
+
+		if f != nil {
+			f.Close()
+		}
+	}
+
+	return l
+}
+
+// close flushes buffered logger and closes host connection.
+func (s *basicPluginHandlerWrapper) close() {
+	if s.closeLog != nil {
+		s.closeLog()
+		s.closeLog = nil
+	}
+
+	if s.conn != nil {
+		s.conn.Close()
+		s.conn = nil
+	}
+}
+
+// logger returns logger passed to Init, before Init lines are written to stderr.
+func (s *basicPluginHandlerWrapper) logger() log.Logger {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.log == nil {
+		s.log = log.NewLogger(nil)
+	}
+
+	return s.log
+}
+
+func (s *basicPluginHandlerWrapper) setLogger(l log.Logger) log.Logger {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.log = l
+
+	return l
+}
+
+// setSlogDefault makes slog send records the same way as logger if enabled with WithSlogDefault.
+func (s *basicPluginHandlerWrapper) setSlogDefault(l log.Logger) {
+	if !s.slogDefault {
+		return
+	}
+
+	h, ok := l.(interface {
+		SlogHandler(slog.Leveler) *log.SlogHandler
+	})
+	if !ok {
+		return
+	}
+
+	s.slogOnce.Do(func() {
+		slog.SetDefault(slog.New(h.SlogHandler(s.slogLevel)))
+	})
+}
+
+func (s *basicPluginHandlerWrapper) init(ctx context.Context, req *apiv1.InitRequest) error {
+	// Host may call Init again, close logger and connection of previous one.
+	s.close()
+
+	cli := s.hostCli
+
+	if cli == nil {
+		dialOpts, err := hostDialOptions(req, s.cert)
+		if err != nil {
+			return err
+		}
+
+		conn, err := grpc.NewClient(req.HostAddr, dialOpts...)
+		if err != nil {
+			return err
+		}
+
+		s.conn = conn
+		cli = apiv1.NewHostServiceClient(conn)
+	}
+
+	l := s.setLogger(s.newLogger(cli))
+	s.setSlogDefault(l)
+
+	return s.BasicPluginHandler.Init(ctx, s.env, l, cli)
 }
 
 func (s *basicPluginHandlerWrapper) Init(ctx context.Context, req *apiv1.InitRequest) (*apiv1.InitResponse, error) {
-	conn, err := grpc.NewClient(req.HostAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	err := s.init(ctx, req)
 	if err != nil {
-		return nil, err
+		return &apiv1.InitResponse{}, err
+	}
+
+	s.mu.Lock()
+	s.initialized = true
+	s.mu.Unlock()
+
+	setServingStatus(s.health, apiv1.BasicPluginService_ServiceDesc.ServiceName)
+
+	return &apiv1.InitResponse{}, nil
+}
+
+func (s *basicPluginHandlerWrapper) Start(ctx context.Context, req *apiv1.StartRequest) (*apiv1.StartResponse, error) {
+	res, err := s.BasicPluginHandler.Start(ctx, req)
+	if err != nil {
+		return res, err
 	}
 
-	s.conn = conn
-	cli := apiv1.NewHostServiceClient(conn)
-	l := log.NewLogger(cli)
+	s.mu.Lock()
+	initialized := s.initialized
+	s.mu.Unlock()
+
+	if initialized {
+		setServingStatus(s.health, "")
+	}
 
-	return &apiv1.InitResponse{}, s.BasicPluginHandler.Init(ctx, s.env, l, cli)
+	return res, nil
 }
 
 type deployPluginHandlerWrapper struct {
 	DeployPluginHandler
 
 	RegistryOptions RegistryOptions
+	applies         *applyCanceler
+	checkpoints     bool
+}
+
+// RegistryConcurrencyArg is a request arg that overrides registry concurrency.
+// It can be either a number used for all phases or an object with init, read and apply keys.
+const RegistryConcurrencyArg = "registry_concurrency"
+
+func concurrencyValue(path string, v any) (int, error) {
+	n, ok := v.(float64)
+	if !ok || n < 0 || n != math.Trunc(n) || n > math.MaxInt32 {
+		return 0, types.NewStatusValidationError(path, "expected non-negative integer")
+	}
+
+	return int(n), nil
+}
+
+func registryConcurrency(c registry.Concurrency, args *structpb.Struct) (registry.Concurrency, error) {
+	v, ok := args.GetFields()[RegistryConcurrencyArg]
+	if !ok {
+		return c, nil
+	}
+
+	switch val := v.AsInterface().(type) {
+	case float64:
+		n, err := concurrencyValue(RegistryConcurrencyArg, val)
+		if err != nil {
+			return c, err
+		}
+
+		return registry.Concurrency{Init: n, Read: n, Apply: n}, nil
+	case map[string]any:
+		for k, kv := range val {
+			var dst *int
+
+			switch k {
+			case "init":
+				dst = &c.Init
+			case "read":
+				dst = &c.Read
+			case "apply":
+				dst = &c.Apply
+			default:
+				return c, types.NewStatusValidationError(RegistryConcurrencyArg+"."+k, "unknown concurrency phase")
+			}
+
+			n, err := concurrencyValue(RegistryConcurrencyArg+"."+k, kv)
+			if err != nil {
+				return c, err
+			}
+
+			*dst = n
+		}
+
+		return c, nil
+	}
+
+	return c, types.NewStatusValidationError(RegistryConcurrencyArg, "expected number or object with init, read and apply keys")
+}
+
+func (o *RegistryOptions) options(read, destroy bool, args *structpb.Struct) (*registry.Options, error) {
+	concurrency, err := registryConcurrency(o.Concurrency, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &registry.Options{
+		Read:            read,
+		Destroy:         destroy,
+		AllowDuplicates: o.AllowDuplicates,
+		StateEncrypter:  o.StateEncrypter,
+		RetryPolicy:     o.RetryPolicy,
+		Concurrency:     concurrency,
+
+		CheckpointInterval: o.CheckpointInterval,
+		Rollback:           o.Rollback,
+		TracerProvider:     o.TracerProvider,
+	}, nil
 }
 
-func createRegistry(opts *registry.Options, apps []*apiv1.AppPlan) *registry.Registry {
+func createRegistry(opts *registry.Options, apps []*apiv1.AppPlan, deps []*apiv1.DependencyPlan) *registry.Registry {
 	reg := registry.NewRegistry(opts)
 
 	for _, plan := range apps {
@@ -98,71 +335,242 @@ func createRegistry(opts *registry.Options, apps []*apiv1.AppPlan) *registry.Reg
 		}
 	}
 
+	for _, plan := range deps {
+		if dep := plan.GetState().GetDependency(); types.DependencyPreventDestroy(dep) {
+			reg.PreventDestroyDependencyResources(dep)
+		}
+	}
+
 	return reg
 }
 
-func (s *deployPluginHandlerWrapper) createRegistry(apps []*apiv1.AppPlan, destroy, read bool) *registry.Registry {
-	return createRegistry(&registry.Options{
-		Read:            read,
-		Destroy:         destroy,
-		AllowDuplicates: s.RegistryOptions.AllowDuplicates,
-	}, apps)
+func registryTargets(targets []*apiv1.ResourceTarget) ([]*registry.ResourceTarget, error) {
+	ret := make([]*registry.ResourceTarget, 0, len(targets))
+
+	for _, t := range targets {
+		rt, err := registry.NewResourceTarget(t.Source, t.Namespace, t.Type, t.Id)
+		if err != nil {
+			return nil, types.NewStatusValidationError("targets", err.Error())
+		}
+
+		ret = append(ret, rt)
+	}
+
+	return ret, nil
+}
+
+func (s *deployPluginHandlerWrapper) registryOptions(destroy, read bool, args *structpb.Struct, targets []*apiv1.ResourceTarget) (*registry.Options, error) {
+	opts, err := s.RegistryOptions.options(read, destroy, args)
+	if err != nil {
+		return nil, err
+	}
+
+	opts.Targets, err = registryTargets(targets)
+	if err != nil {
+		return nil, err
+	}
+
+	return opts, nil
+}
+
+func (s *deployPluginHandlerWrapper) createRegistry(apps []*apiv1.AppPlan, deps []*apiv1.DependencyPlan, destroy, read bool, args *structpb.Struct, targets []*apiv1.ResourceTarget) (*registry.Registry, error) {
+	opts, err := s.registryOptions(destroy, read, args, targets)
+	if err != nil {
+		return nil, err
+	}
+
+	return createRegistry(opts, apps, deps), nil
 }
 
 func (s *deployPluginHandlerWrapper) Plan(ctx context.Context, r *apiv1.PlanRequest) (*apiv1.PlanResponse, error) {
-	reg := s.createRegistry(r.Apps, r.Destroy, r.Verify)
-	return s.DeployPluginHandler.Plan(ctx, reg, r)
+	reg, err := s.createRegistry(r.Apps, r.Dependencies, r.Destroy, r.Verify, r.Args, r.Targets)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.DeployPluginHandler.Plan(ctx, reg, r)
+	if res != nil {
+		res.Fingerprint = PlanFingerprint(r)
+	}
+
+	return res, err
 }
 
 func (s *deployPluginHandlerWrapper) Apply(r *apiv1.ApplyRequest, stream apiv1.DeployPluginService_ApplyServer) error {
-	reg := s.createRegistry(r.Apps, r.Destroy, false)
-	return s.DeployPluginHandler.Apply(r, reg, stream)
+	opts, err := s.registryOptions(r.Destroy, false, r.Args, r.Targets)
+	if err != nil {
+		return err
+	}
+
+	if r.SavedPlan != nil {
+		if r.SavedPlan.Fingerprint != applyFingerprint(r) {
+			return types.NewStatusSavedPlanError("inputs or state changed since plan was created")
+		}
+
+		opts.SavedPlan = r.SavedPlan.Plan
+		if opts.SavedPlan == nil {
+			opts.SavedPlan = &apiv1.Plan{}
+		}
+	}
+
+	reg := createRegistry(opts, r.Apps, r.Dependencies)
+
+	ctx, done := s.applies.start(stream.Context())
+	defer done()
+
+	cstream := &cancelableApplyServer{DeployPluginService_ApplyServer: stream, ctx: ctx}
+
+	// Handler can still override it with its own hook.
+	if s.checkpoints {
+		reg.SetCheckpointHook(DefaultRegistryCheckpointCallback(cstream, r.State))
+	}
+
+	err = s.DeployPluginHandler.Apply(r, reg, cstream)
+
+	// Apply was cancelled with CancelApply, send partial state if handler didn't.
+	if err != nil && cancelledByUser(ctx, stream.Context()) && !cstream.done {
+		return sendPartialApplyDone(r, reg, stream)
+	}
+
+	if errors.Is(err, registry.ErrPlanChanged) {
+		return types.NewStatusSavedPlanError(err.Error())
+	}
+
+	return err
+}
+
+func (s *deployPluginHandlerWrapper) CancelApply(ctx context.Context, r *apiv1.CancelApplyRequest) (*apiv1.CancelApplyResponse, error) {
+	return &apiv1.CancelApplyResponse{Cancelled: s.applies.cancelAll()}, nil
+}
+
+func (s *deployPluginHandlerWrapper) Import(ctx context.Context, r *apiv1.ImportRequest) (*apiv1.ImportResponse, error) {
+	h, ok := s.DeployPluginHandler.(DeployImportHandler)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "plugin does not support import")
+	}
+
+	reg, err := s.createRegistry(r.Apps, r.Dependencies, false, false, r.Args, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.Import(ctx, reg, r)
+}
+
+func (s *deployPluginHandlerWrapper) DetectDrift(ctx context.Context, r *apiv1.DetectDriftRequest) (*apiv1.DetectDriftResponse, error) {
+	h, ok := s.DeployPluginHandler.(DeployDriftHandler)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "plugin does not support drift detection")
+	}
+
+	reg, err := s.createRegistry(r.Apps, r.Dependencies, false, true, r.Args, r.Targets)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.DetectDrift(ctx, reg, r)
 }
 
 type dnsPluginHandlerWrapper struct {
 	DNSPluginHandler
 
 	RegistryOptions RegistryOptions
+	applies         *applyCanceler
+	checkpoints     bool
 }
 
-func (s *dnsPluginHandlerWrapper) createRegistry(read, destroy bool) *registry.Registry {
-	return createRegistry(&registry.Options{
-		Read:            read,
-		Destroy:         destroy,
-		AllowDuplicates: s.RegistryOptions.AllowDuplicates,
-	}, nil)
+func (s *dnsPluginHandlerWrapper) createRegistry(read, destroy bool, args *structpb.Struct) (*registry.Registry, error) {
+	opts, err := s.RegistryOptions.options(read, destroy, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return createRegistry(opts, nil, nil), nil
 }
 
 func (s *dnsPluginHandlerWrapper) PlanDNS(ctx context.Context, r *apiv1.PlanDNSRequest) (*apiv1.PlanDNSResponse, error) {
-	reg := s.createRegistry(r.Verify, r.Destroy)
+	reg, err := s.createRegistry(r.Verify, r.Destroy, r.Args)
+	if err != nil {
+		return nil, err
+	}
+
 	return s.DNSPluginHandler.PlanDNS(ctx, reg, r)
 }
 
 func (s *dnsPluginHandlerWrapper) ApplyDNS(r *apiv1.ApplyDNSRequest, stream apiv1.DNSPluginService_ApplyDNSServer) error {
-	reg := s.createRegistry(false, r.Destroy)
-	return s.DNSPluginHandler.ApplyDNS(r, reg, stream)
+	reg, err := s.createRegistry(false, r.Destroy, r.Args)
+	if err != nil {
+		return err
+	}
+
+	ctx, done := s.applies.start(stream.Context())
+	defer done()
+
+	cstream := &cancelableApplyDNSServer{DNSPluginService_ApplyDNSServer: stream, ctx: ctx}
+
+	// Handler can still override it with its own hook.
+	if s.checkpoints {
+		reg.SetCheckpointHook(DefaultRegistryCheckpointDNSCallback(cstream, r.State))
+	}
+
+	err = s.DNSPluginHandler.ApplyDNS(r, reg, cstream)
+
+	// Apply was cancelled with CancelApply, send partial state if handler didn't.
+	if err != nil && cancelledByUser(ctx, stream.Context()) && !cstream.done {
+		return sendPartialApplyDNSDone(r, reg, stream)
+	}
+
+	return err
 }
 
 type monitoringPluginHandlerWrapper struct {
 	MonitoringPluginHandler
 
 	RegistryOptions RegistryOptions
+	applies         *applyCanceler
+	checkpoints     bool
 }
 
-func (s *monitoringPluginHandlerWrapper) createRegistry(read, destroy bool) *registry.Registry {
-	return createRegistry(&registry.Options{
-		Read:            read,
-		Destroy:         destroy,
-		AllowDuplicates: s.RegistryOptions.AllowDuplicates,
-	}, nil)
+func (s *monitoringPluginHandlerWrapper) createRegistry(read, destroy bool, args *structpb.Struct) (*registry.Registry, error) {
+	opts, err := s.RegistryOptions.options(read, destroy, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return createRegistry(opts, nil, nil), nil
 }
 
 func (s *monitoringPluginHandlerWrapper) PlanMonitoring(ctx context.Context, r *apiv1.PlanMonitoringRequest) (*apiv1.PlanMonitoringResponse, error) {
-	reg := s.createRegistry(r.Verify, r.Destroy)
+	reg, err := s.createRegistry(r.Verify, r.Destroy, r.Args)
+	if err != nil {
+		return nil, err
+	}
+
 	return s.MonitoringPluginHandler.PlanMonitoring(ctx, reg, r)
 }
 
 func (s *monitoringPluginHandlerWrapper) ApplyMonitoring(r *apiv1.ApplyMonitoringRequest, stream apiv1.MonitoringPluginService_ApplyMonitoringServer) error {
-	reg := s.createRegistry(false, r.Destroy)
-	return s.MonitoringPluginHandler.ApplyMonitoring(r, reg, stream)
+	reg, err := s.createRegistry(false, r.Destroy, r.Args)
+	if err != nil {
+		return err
+	}
+
+	ctx, done := s.applies.start(stream.Context())
+	defer done()
+
+	cstream := &cancelableApplyMonitoringServer{MonitoringPluginService_ApplyMonitoringServer: stream, ctx: ctx}
+
+	// Handler can still override it with its own hook.
+	if s.checkpoints {
+		reg.SetCheckpointHook(DefaultRegistryCheckpointMonitoringCallback(cstream, r.State))
+	}
+
+	err = s.MonitoringPluginHandler.ApplyMonitoring(r, reg, cstream)
+
+	// Apply was cancelled with CancelApply, send partial state if handler didn't.
+	if err != nil && cancelledByUser(ctx, stream.Context()) && !cstream.done {
+		return sendPartialApplyMonitoringDone(r, reg, stream)
+	}
+
+	return err
 }
@@ -0,0 +1,147 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/outblocks/outblocks-plugin-go/env"
+	apiv1 "github.com/outblocks/outblocks-plugin-go/gen/api/v1"
+	"github.com/outblocks/outblocks-plugin-go/log"
+	"github.com/outblocks/outblocks-plugin-go/registry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestRegistryConcurrency(t *testing.T) {
+	def := registry.Concurrency{Init: 1, Read: 2, Apply: 3}
+
+	tests := []struct {
+		name string
+		arg  any
+		want registry.Concurrency
+		err  bool
+	}{
+		{name: "unset", want: def},
+		{name: "number", arg: 5, want: registry.Concurrency{Init: 5, Read: 5, Apply: 5}},
+		{name: "zero", arg: 0, want: registry.Concurrency{}},
+		{name: "object", arg: map[string]any{"read": 7, "apply": 8}, want: registry.Concurrency{Init: 1, Read: 7, Apply: 8}},
+		{name: "negative", arg: -1, err: true},
+		{name: "fraction", arg: 1.5, err: true},
+		{name: "string", arg: "4", err: true},
+		{name: "bool", arg: true, err: true},
+		{name: "list", arg: []any{1}, err: true},
+		{name: "negative phase", arg: map[string]any{"init": -2}, err: true},
+		{name: "string phase", arg: map[string]any{"apply": "2"}, err: true},
+		{name: "unknown phase", arg: map[string]any{"plan": 2}, err: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var args *structpb.Struct
+
+			if tc.arg != nil {
+				var err error
+
+				args, err = structpb.NewStruct(map[string]any{RegistryConcurrencyArg: tc.arg})
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			got, err := registryConcurrency(def, args)
+			if tc.err {
+				if status.Code(err) != codes.InvalidArgument {
+					t.Fatalf("expected InvalidArgument, got: %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != tc.want {
+				t.Fatalf("expected %+v, got: %+v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestSetSlogDefault(t *testing.T) {
+	prev := slog.Default()
+	t.Cleanup(func() { slog.SetDefault(prev) })
+
+	w := &basicPluginHandlerWrapper{}
+	w.setSlogDefault(log.NewLogger(nil))
+
+	if slog.Default() != prev {
+		t.Fatal("expected default slog logger to be kept without WithSlogDefault")
+	}
+
+	w.slogDefault = true
+	w.setSlogDefault(log.NewLogger(nil))
+
+	got := slog.Default()
+	if _, ok := got.Handler().(*log.SlogHandler); !ok {
+		t.Fatalf("expected host slog handler, got: %T", got.Handler())
+	}
+
+	w.setSlogDefault(log.NewLogger(nil))
+
+	if slog.Default() != got {
+		t.Fatal("expected default slog logger to be set only once")
+	}
+}
+
+func TestLoggerBeforeInit(t *testing.T) {
+	w := &basicPluginHandlerWrapper{}
+
+	l := w.logger()
+	if l == nil {
+		t.Fatal("expected fallback logger before Init")
+	}
+
+	if w.logger() != l {
+		t.Fatal("expected fallback logger to be reused")
+	}
+}
+
+func TestInitClosesPreviousLogger(t *testing.T) {
+	var fallback bytes.Buffer
+
+	w := &basicPluginHandlerWrapper{
+		BasicPluginHandler: basicHandler{},
+		env:                env.NewEnv(),
+		hostCli:            apiv1.NewHostServiceClient(nil),
+		bufferedLogging:    &log.BufferedOptions{Fallback: &fallback},
+	}
+
+	err := w.init(context.Background(), &apiv1.InitRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first := w.logger()
+
+	err = w.init(context.Background(), &apiv1.InitRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer w.close()
+
+	if w.logger() == first {
+		t.Fatal("expected new logger after second Init")
+	}
+
+	// Closed logger writes lines directly to fallback.
+	first.Infoln("after reinit")
+
+	if fallback.String() != "INFO after reinit\n" {
+		t.Fatalf("expected previous logger to be closed, got: %q", fallback.String())
+	}
+}
@@ -0,0 +1,86 @@
+package plugin
+
+import (
+	"context"
+	"os"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+)
+
+// EnvPluginTraceFile enables tracing with spans written as JSON to given file if no tracer provider was set.
+const EnvPluginTraceFile = "OUTBLOCKS_PLUGIN_TRACE_FILE"
+
+// WithTracerProvider enables tracing of every RPC and registry phases. Trace context is propagated from host
+// through gRPC metadata using W3C Trace Context.
+func WithTracerProvider(tp trace.TracerProvider) ServerOption {
+	return func(s *Server) {
+		s.registryOptions.TracerProvider = tp
+	}
+}
+
+// NewFileTracerProvider creates tracer provider exporting spans as JSON lines to file.
+// Shutdown has to be called to flush remaining spans and close the file.
+func NewFileTracerProvider(path string) (*sdktrace.TracerProvider, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	exp, err := stdouttrace.New(stdouttrace.WithWriter(f))
+	if err != nil {
+		f.Close()
+
+		return nil, err
+	}
+
+	tp := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exp))
+	tp.RegisterSpanProcessor(closerSpanProcessor{f})
+
+	return tp, nil
+}
+
+// closerSpanProcessor closes file on shutdown, it is registered after exporter so that spans are flushed first.
+type closerSpanProcessor struct {
+	f *os.File
+}
+
+func (p closerSpanProcessor) OnStart(context.Context, sdktrace.ReadWriteSpan) {}
+func (p closerSpanProcessor) OnEnd(sdktrace.ReadOnlySpan)                     {}
+func (p closerSpanProcessor) ForceFlush(context.Context) error                { return nil }
+func (p closerSpanProcessor) Shutdown(context.Context) error                  { return p.f.Close() }
+
+// tracerProviderFromEnv sets up file tracer provider if requested by host and no provider was set.
+func (s *Server) tracerProviderFromEnv() (shutdown func(), err error) {
+	path := os.Getenv(EnvPluginTraceFile)
+	if s.registryOptions.TracerProvider != nil || path == "" {
+		return func() {}, nil
+	}
+
+	tp, err := NewFileTracerProvider(path)
+	if err != nil {
+		return nil, err
+	}
+
+	s.registryOptions.TracerProvider = tp
+
+	return func() { _ = tp.Shutdown(context.Background()) }, nil
+}
+
+func (s *Server) tracingOptions() []grpc.ServerOption {
+	tp := s.registryOptions.TracerProvider
+	if tp == nil {
+		return nil
+	}
+
+	return []grpc.ServerOption{
+		grpc.StatsHandler(otelgrpc.NewServerHandler(
+			otelgrpc.WithTracerProvider(tp),
+			otelgrpc.WithPropagators(propagation.TraceContext{}),
+		)),
+	}
+}
@@ -0,0 +1,270 @@
+package plugin
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	apiv1 "github.com/outblocks/outblocks-plugin-go/gen/api/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	TransportTCP  = "tcp"
+	TransportUnix = "unix"
+
+	// EnvPluginTransport selects transport plugin listens on, defaults to TransportTCP.
+	EnvPluginTransport = "OUTBLOCKS_PLUGIN_TRANSPORT"
+	// EnvPluginClientCert holds PEM encoded one-time host client certificate. If set, plugin serves TLS
+	// with generated certificate sent in handshake and accepts only this client certificate.
+	EnvPluginClientCert = "OUTBLOCKS_PLUGIN_CLIENT_CERT"
+	// EnvPluginNonce holds nonce that host has to send in metadata of every call.
+	EnvPluginNonce = "OUTBLOCKS_PLUGIN_NONCE"
+
+	NonceMetadataKey = "outblocks-nonce"
+
+	tlsServerName = "localhost"
+)
+
+type transport struct {
+	listener net.Listener
+	creds    credentials.TransportCredentials
+	cert     *tls.Certificate
+	certPEM  []byte
+	nonce    string
+	cleanup  func()
+}
+
+func listen(ctx context.Context, typ string) (net.Listener, func(), error) {
+	switch typ {
+	case "", TransportTCP:
+		l, err := (&net.ListenConfig{}).Listen(ctx, "tcp4", "127.0.0.1:0")
+
+		return l, func() {}, err
+	case TransportUnix:
+		dir, err := os.MkdirTemp("", "outblocks-plugin-")
+		if err != nil {
+			return nil, nil, err
+		}
+
+		cleanup := func() { _ = os.RemoveAll(dir) }
+
+		l, err := (&net.ListenConfig{}).Listen(ctx, "unix", filepath.Join(dir, "plugin.sock"))
+		if err != nil {
+			cleanup()
+
+			return nil, nil, err
+		}
+
+		return l, cleanup, nil
+	}
+
+	return nil, nil, fmt.Errorf("unknown plugin transport: %s", typ)
+}
+
+func generateCertificate() (*tls.Certificate, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	tmpl := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: tlsServerName},
+		DNSNames:              []string{tlsServerName},
+		NotBefore:             now.Add(-time.Minute),
+		NotAfter:              now.Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cert, err := tls.X509KeyPair(certPEM, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &cert, certPEM, nil
+}
+
+func certPool(certPEM []byte) (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+
+	if !pool.AppendCertsFromPEM(certPEM) {
+		return nil, errors.New("invalid PEM certificate")
+	}
+
+	return pool, nil
+}
+
+// newTransport prepares listener and credentials according to environment set by host.
+func newTransport(ctx context.Context) (*transport, error) {
+	l, cleanup, err := listen(ctx, os.Getenv(EnvPluginTransport))
+	if err != nil {
+		return nil, err
+	}
+
+	t := &transport{
+		listener: l,
+		creds:    insecure.NewCredentials(),
+		nonce:    os.Getenv(EnvPluginNonce),
+		cleanup:  cleanup,
+	}
+
+	clientCert := os.Getenv(EnvPluginClientCert)
+
+	// Secrets are not needed anymore and should not be inherited by subprocesses.
+	_ = os.Unsetenv(EnvPluginNonce)
+	_ = os.Unsetenv(EnvPluginClientCert)
+
+	if clientCert == "" {
+		return t, nil
+	}
+
+	clientCAs, err := certPool([]byte(clientCert))
+	if err != nil {
+		cleanup()
+
+		return nil, fmt.Errorf("%s: %w", EnvPluginClientCert, err)
+	}
+
+	t.cert, t.certPEM, err = generateCertificate()
+	if err != nil {
+		cleanup()
+
+		return nil, err
+	}
+
+	t.creds = credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{*t.cert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
+		MinVersion:   tls.VersionTLS13,
+	})
+
+	return t, nil
+}
+
+func (t *transport) network() string {
+	if t.listener.Addr().Network() == "unix" {
+		return TransportUnix
+	}
+
+	return TransportTCP
+}
+
+func (t *transport) serverOptions() []grpc.ServerOption {
+	opts := []grpc.ServerOption{grpc.Creds(t.creds)}
+
+	if t.nonce != "" {
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+				if err := checkNonce(ctx, t.nonce); err != nil {
+					return nil, err
+				}
+
+				return handler(ctx, req)
+			}),
+			grpc.ChainStreamInterceptor(func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+				if err := checkNonce(ss.Context(), t.nonce); err != nil {
+					return err
+				}
+
+				return handler(srv, ss)
+			}),
+		)
+	}
+
+	return opts
+}
+
+func checkNonce(ctx context.Context, nonce string) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	for _, v := range md.Get(NonceMetadataKey) {
+		if subtle.ConstantTimeCompare([]byte(v), []byte(nonce)) == 1 {
+			return nil
+		}
+	}
+
+	return status.Error(codes.Unauthenticated, "invalid nonce")
+}
+
+type nonceCredentials string
+
+func (n nonceCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{NonceMetadataKey: string(n)}, nil
+}
+
+func (n nonceCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// hostDialOptions returns options for connecting to host. Plugin certificate is used as client certificate.
+func hostDialOptions(r *apiv1.InitRequest, cert *tls.Certificate) ([]grpc.DialOption, error) {
+	creds := insecure.NewCredentials()
+
+	if len(r.HostCert) > 0 {
+		rootCAs, err := certPool(r.HostCert)
+		if err != nil {
+			return nil, fmt.Errorf("host cert: %w", err)
+		}
+
+		cfg := &tls.Config{
+			RootCAs:    rootCAs,
+			ServerName: tlsServerName,
+			MinVersion: tls.VersionTLS13,
+		}
+
+		if cert != nil {
+			cfg.Certificates = []tls.Certificate{*cert}
+		}
+
+		creds = credentials.NewTLS(cfg)
+	}
+
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+
+	if r.HostNonce != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(nonceCredentials(r.HostNonce)))
+	}
+
+	return opts, nil
+}
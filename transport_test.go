@@ -0,0 +1,135 @@
+package plugin
+
+import (
+	"context"
+	"crypto/tls"
+	"os"
+	"testing"
+
+	apiv1 "github.com/outblocks/outblocks-plugin-go/gen/api/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func serveTransport(t *testing.T, tr *transport) {
+	t.Helper()
+
+	srv := grpc.NewServer(tr.serverOptions()...)
+	healthpb.RegisterHealthServer(srv, health.NewServer())
+
+	go func() { _ = srv.Serve(tr.listener) }()
+
+	t.Cleanup(func() {
+		srv.Stop()
+		tr.cleanup()
+	})
+}
+
+func checkHealth(t *testing.T, tr *transport, opts ...grpc.DialOption) error {
+	t.Helper()
+
+	conn, err := grpc.NewClient("passthrough:///"+tr.listener.Addr().String(), opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer conn.Close()
+
+	_, err = healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+
+	return err
+}
+
+func TestTransportNonce(t *testing.T) {
+	t.Setenv(EnvPluginNonce, "secret")
+
+	tr, err := newTransport(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := os.LookupEnv(EnvPluginNonce); ok {
+		t.Fatal("expected nonce to be removed from environment")
+	}
+
+	serveTransport(t, tr)
+
+	for _, tc := range []struct {
+		nonce string
+		code  codes.Code
+	}{
+		{nonce: "", code: codes.Unauthenticated},
+		{nonce: "wrong", code: codes.Unauthenticated},
+		{nonce: "secret", code: codes.OK},
+	} {
+		opts, err := hostDialOptions(&apiv1.InitRequest{HostNonce: tc.nonce}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = checkHealth(t, tr, opts...)
+		if status.Code(err) != tc.code {
+			t.Fatalf("nonce %q: expected %s, got: %v", tc.nonce, tc.code, err)
+		}
+	}
+}
+
+func TestTransportClientCert(t *testing.T) {
+	hostCert, hostPEM, err := generateCertificate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	otherCert, _, err := generateCertificate()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv(EnvPluginClientCert, string(hostPEM))
+
+	tr, err := newTransport(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := os.LookupEnv(EnvPluginClientCert); ok {
+		t.Fatal("expected client cert to be removed from environment")
+	}
+
+	serveTransport(t, tr)
+
+	rootCAs, err := certPool(tr.certPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dial := func(cert *tls.Certificate) error {
+		creds := credentials.NewTLS(&tls.Config{
+			Certificates: []tls.Certificate{*cert},
+			RootCAs:      rootCAs,
+			ServerName:   tlsServerName,
+			MinVersion:   tls.VersionTLS13,
+		})
+
+		return checkHealth(t, tr, grpc.WithTransportCredentials(creds))
+	}
+
+	if err := dial(otherCert); status.Code(err) != codes.Unavailable {
+		t.Fatalf("expected client cert not signed by host to be rejected, got: %v", err)
+	}
+
+	if err := dial(hostCert); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHostDialOptionsInvalidCert(t *testing.T) {
+	_, err := hostDialOptions(&apiv1.InitRequest{HostCert: []byte("not a certificate")}, nil)
+	if err == nil {
+		t.Fatal("expected error for malformed host cert")
+	}
+}
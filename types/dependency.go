@@ -46,6 +46,15 @@ type DatabaseDepOptions struct {
 	Tier    string                            `json:"tier"`
 	Flags   map[string]string                 `json:"flags"`
 	Users   map[string]*DatabaseDepOptionUser `json:"users"`
+
+	// PreventDestroy makes plan fail if any resource of dependency would be deleted or recreated.
+	PreventDestroy bool `json:"prevent_destroy"`
+}
+
+// DependencyPreventDestroy returns true if prevent_destroy option is set in dependency properties,
+// see DatabaseDepOptions.PreventDestroy.
+func DependencyPreventDestroy(d *apiv1.Dependency) bool {
+	return d.GetProperties().GetFields()["prevent_destroy"].GetBoolValue()
 }
 
 func NewDatabaseDepOptions(in map[string]any) (*DatabaseDepOptions, error) {
@@ -13,6 +13,7 @@ const (
 	LockErrorMessage       = "lock error"
 	StateLockErrorMessage  = "state lock error"
 	ValidationErrorMessage = "validation error"
+	SavedPlanErrorMessage  = "saved plan error"
 )
 
 func NewStatusValidationError(path, msg string) error {
@@ -26,6 +27,10 @@ func NewStatusValidationError(path, msg string) error {
 	return st.Err()
 }
 
+func NewStatusSavedPlanError(msg string) error {
+	return status.Error(codes.FailedPrecondition, SavedPlanErrorMessage+": "+msg)
+}
+
 func NewStatusLockError(det ...*apiv1.LockError) error {
 	st := status.New(codes.FailedPrecondition, LockErrorMessage)
 
@@ -2,6 +2,9 @@ package errgroup
 
 import (
 	"context"
+	"fmt"
+	"runtime/debug"
+	"sync"
 
 	"golang.org/x/sync/errgroup"
 	"golang.org/x/sync/semaphore"
@@ -12,15 +15,30 @@ type Runner interface {
 	Wait() error
 }
 
+// PanicError holds value and stack trace of panic recovered in one of group goroutines.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
 type Group struct {
 	*errgroup.Group
 
 	ctx context.Context
 	sem *semaphore.Weighted
+
+	panicOnce sync.Once
+	panicErr  *PanicError
 }
 
 func WithContext(ctx context.Context) (Runner, context.Context) {
-	return errgroup.WithContext(ctx)
+	gr, ctx := errgroup.WithContext(ctx)
+
+	return &Group{Group: gr, ctx: ctx}, ctx
 }
 
 func WithConcurrency(ctx context.Context, concurrency int) (Runner, context.Context) {
@@ -30,14 +48,41 @@ func WithConcurrency(ctx context.Context, concurrency int) (Runner, context.Cont
 	return &Group{Group: gr, sem: sem, ctx: ctx}, ctx
 }
 
+// Go runs f in new goroutine. Panic in f cancels the group and is propagated to caller of Wait
+// so that it can be handled in the goroutine that started the group.
 func (g *Group) Go(f func() error) {
-	g.Group.Go(func() error {
-		err := g.sem.Acquire(g.ctx, 1)
-		if err != nil {
-			return err
+	g.Group.Go(func() (err error) {
+		defer func() {
+			if v := recover(); v != nil {
+				pe := &PanicError{Value: v, Stack: debug.Stack()}
+
+				g.panicOnce.Do(func() {
+					g.panicErr = pe
+				})
+
+				err = pe
+			}
+		}()
+
+		if g.sem != nil {
+			err := g.sem.Acquire(g.ctx, 1)
+			if err != nil {
+				return err
+			}
+			defer g.sem.Release(1)
 		}
-		defer g.sem.Release(1)
 
 		return f()
 	})
 }
+
+// Wait waits for all goroutines and returns first error. If any of them panicked, Wait panics with *PanicError.
+func (g *Group) Wait() error {
+	err := g.Group.Wait()
+
+	if g.panicErr != nil {
+		panic(g.panicErr)
+	}
+
+	return err
+}